@@ -0,0 +1,198 @@
+// Package addr provides a common interface for MAC, EUI-64, IPv4 and IPv6
+// addresses and a registry of address families.
+package addr
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"strings"
+	"sync"
+
+	"github.com/hwipl/random-addr/ipv4"
+	"github.com/hwipl/random-addr/ipv6"
+	"github.com/hwipl/random-addr/mac"
+)
+
+// ErrUnknownFamily is returned if an address family is not registered
+var ErrUnknownFamily = errors.New("unknown address family")
+
+// Address is an address of any family
+type Address interface {
+	// Bytes returns the address as a byte slice
+	Bytes() []byte
+
+	// BitLen returns the length of the address in bits
+	BitLen() int
+
+	// String returns the address as string
+	String() string
+
+	// Binary returns the address as a binary string
+	Binary() string
+
+	// Explain returns an explanation of the address and its structure
+	Explain() string
+
+	// Table returns all information about the address as a table
+	Table() string
+
+	// Type returns the type of the address
+	Type() string
+}
+
+// Family is an address family
+type Family struct {
+	// Name is the name of the family, e.g., "mac"
+	Name string
+
+	// Title is the human readable name of the family, e.g., "MAC"
+	Title string
+
+	// Random returns a random address with random bytes read from r
+	Random func(r io.Reader) (Address, error)
+
+	// Parse parses and returns the address in s
+	Parse func(s string) (Address, error)
+}
+
+var (
+	// familiesMutex protects families
+	familiesMutex sync.RWMutex
+
+	// families contains all registered address families
+	families []*Family
+)
+
+// Register registers the address family f, it replaces an existing family
+// with the same name
+func Register(f *Family) {
+	familiesMutex.Lock()
+	defer familiesMutex.Unlock()
+
+	for i, family := range families {
+		if family.Name == f.Name {
+			families[i] = f
+			return
+		}
+	}
+	families = append(families, f)
+}
+
+// Lookup returns the address family with name
+func Lookup(name string) (*Family, error) {
+	familiesMutex.RLock()
+	defer familiesMutex.RUnlock()
+
+	for _, f := range families {
+		if f.Name == name {
+			return f, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnknownFamily, name)
+}
+
+// Families returns all registered address families in registration order
+func Families() []*Family {
+	familiesMutex.RLock()
+	defer familiesMutex.RUnlock()
+
+	return append([]*Family(nil), families...)
+}
+
+// Detect returns the name of the address family of the address in s,
+// the address is not validated. An EUI-64 address in colon notation, e.g.,
+// 02:00:5e:ff:fe:00:53:01, is also a valid IPv6 address and is detected as
+// IPv6, EUI-64 addresses are only detected in the other notations, e.g.,
+// 02-00-5e-ff-fe-00-53-01
+func Detect(s string) string {
+	// check if s is an IP address, with or without prefix, first to
+	// resolve the ambiguity with EUI-64 addresses in colon notation
+	a, _, _ := strings.Cut(s, "/")
+	if ip, err := netip.ParseAddr(a); err == nil {
+		if ip.Is4() {
+			return "ipv4"
+		}
+		return "ipv6"
+	}
+
+	// check if s is an EUI-64 address
+	if _, err := mac.TryParseEUI64(s); err == nil {
+		return "eui64"
+	}
+
+	// treat everything else as MAC address
+	return "mac"
+}
+
+// Parse parses and returns the address in s, the address family is detected
+// automatically
+func Parse(s string) (Address, error) {
+	f, err := Lookup(Detect(s))
+	if err != nil {
+		return nil, err
+	}
+	return f.Parse(s)
+}
+
+// result returns the address a and error err as Address, it makes sure a
+// is nil if there is an error
+func result[T Address](a T, err error) (Address, error) {
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// init registers the built-in address families
+func init() {
+	Register(&Family{
+		Name:  "mac",
+		Title: "MAC",
+		Random: func(r io.Reader) (Address, error) {
+			return result(mac.NewGenerator(r).Random())
+		},
+		Parse: func(s string) (Address, error) {
+			return result(mac.TryParse(s))
+		},
+	})
+	Register(&Family{
+		Name:  "eui64",
+		Title: "EUI-64",
+		Random: func(r io.Reader) (Address, error) {
+			return result(mac.NewGenerator(r).RandomEUI64())
+		},
+		Parse: func(s string) (Address, error) {
+			return result(mac.TryParseEUI64(s))
+		},
+	})
+	Register(&Family{
+		Name:  "ipv4",
+		Title: "IPv4",
+		Random: func(r io.Reader) (Address, error) {
+			return result(ipv4.NewGenerator(r).Random())
+		},
+		Parse: func(s string) (Address, error) {
+			return result(ipv4.TryParse(s))
+		},
+	})
+	Register(&Family{
+		Name:  "ipv6",
+		Title: "IPv6",
+		Random: func(r io.Reader) (Address, error) {
+			return result(ipv6.NewGenerator(r).Random())
+		},
+		Parse: func(s string) (Address, error) {
+			return result(ipv6.TryParse(s))
+		},
+	})
+}
+
+// make sure the built-in address types implement Address
+var (
+	_ Address = (*mac.MAC)(nil)
+	_ Address = (*mac.EUI64)(nil)
+	_ Address = (*ipv4.IPv4)(nil)
+	_ Address = (*ipv6.IPv6)(nil)
+)
@@ -0,0 +1,84 @@
+package addr
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// TestParse tests Parse
+func TestParse(t *testing.T) {
+	for _, test := range []struct {
+		s      string
+		bitLen int
+	}{
+		{"00:00:5e:00:53:01", 48},
+		{"00-00-5e-ff-fe-00-53-01", 64},
+		{"00:00:5e:ff:fe:00:53:01", 128},
+		{"192.0.2.1", 32},
+		{"192.0.2.1/24", 32},
+		{"2001:db8::1", 128},
+		{"2001:db8::1/64", 128},
+	} {
+		a, err := Parse(test.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.BitLen() != test.bitLen {
+			t.Errorf("%s: got %d, want %d", test.s, a.BitLen(),
+				test.bitLen)
+		}
+	}
+
+	// test invalid address
+	a, err := Parse("invalid")
+	if err == nil || a != nil {
+		t.Errorf("got %v, %v, want error", a, err)
+	}
+}
+
+// TestDetect tests Detect
+func TestDetect(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want string
+	}{
+		{"00:00:5e:00:53:01", "mac"},
+		{"00-00-5e-00-53-01", "mac"},
+		{"0000.5e00.5301", "mac"},
+		{"00-00-5e-ff-fe-00-53-01", "eui64"},
+		{"0000.5eff.fe00.5301", "eui64"},
+		{"192.0.2.1/24", "ipv4"},
+		{"2001:db8::1", "ipv6"},
+
+		// full-form IPv6 addresses with 2 digit groups are also
+		// EUI-64 addresses in colon notation, IPv6 is preferred
+		{"10:20:30:40:50:60:70:80", "ipv6"},
+		{"00:00:5e:ff:fe:00:53:01", "ipv6"},
+	} {
+		if got := Detect(test.s); got != test.want {
+			t.Errorf("%s: got %s, want %s", test.s, got, test.want)
+		}
+	}
+}
+
+// TestLookup tests Lookup
+func TestLookup(t *testing.T) {
+	for _, name := range []string{"mac", "eui64", "ipv4", "ipv6"} {
+		f, err := Lookup(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		a, err := f.Random(bytes.NewReader(make([]byte, 16)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b := a.Bytes(); !bytes.Equal(b, make([]byte, len(b))) {
+			t.Errorf("%s: got %v, want zero bytes", name, b)
+		}
+	}
+
+	if _, err := Lookup("ipx"); !errors.Is(err, ErrUnknownFamily) {
+		t.Errorf("got %v, want %v", err, ErrUnknownFamily)
+	}
+}
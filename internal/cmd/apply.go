@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"net"
+
+	"github.com/hwipl/random-addr/internal/link"
+	"github.com/hwipl/random-addr/mac"
+)
+
+// applyFlags are the command line flags for applying an address to a
+// network interface
+type applyFlags struct {
+	iface   string
+	restore bool
+	dryRun  bool
+}
+
+// addFlags adds the apply flags to fs
+func (f *applyFlags) addFlags(fs *flag.FlagSet) {
+	fs.StringVar(&f.iface, "apply", "",
+		"set the generated address on network `interface` via rtnetlink")
+	fs.BoolVar(&f.restore, "restore", false,
+		"restore the permanent hardware address of the -apply interface")
+	fs.BoolVar(&f.dryRun, "dry-run", false,
+		"show the change of -apply without making it")
+}
+
+// permanentAddr returns the permanent hardware address of an interface, it
+// is replaced in tests because virtual interfaces do not have one
+var permanentAddr = link.PermanentAddr
+
+// restoreFlags are the flags that can be combined with -restore, all other
+// flags configure the generation of addresses
+var restoreFlags = map[string]bool{
+	"apply":    true,
+	"restore":  true,
+	"dry-run":  true,
+	"notation": true,
+	"oui-file": true,
+}
+
+// check checks the apply flags in f and the flags set in fs
+func (f *applyFlags) check(fs *flag.FlagSet, g *genFlags,
+	o *formatFlags) error {
+	if f.iface == "" {
+		if f.restore || f.dryRun {
+			return usageErrorf("-restore and -dry-run require -apply")
+		}
+		return nil
+	}
+	if f.restore {
+		var err error
+		fs.Visit(func(fl *flag.Flag) {
+			if err == nil && !restoreFlags[fl.Name] {
+				err = usageErrorf("-restore and -%s are exclusive",
+					fl.Name)
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if g.n != 1 {
+		return usageErrorf("-apply requires -n 1")
+	}
+	if !o.text() {
+		return usageErrorf("-apply and -format are exclusive")
+	}
+	return nil
+}
+
+// apply sets m on the interface in f and prints the old and new address in
+// notation n
+func (f *applyFlags) apply(m *mac.MAC, n mac.Notation) error {
+	ifi, err := net.InterfaceByName(f.iface)
+	if err != nil {
+		return fmt.Errorf("-apply: %s: %w", f.iface, err)
+	}
+	old, err := mac.TryParse(ifi.HardwareAddr.String())
+	if err != nil {
+		return fmt.Errorf("-apply: %s is not an Ethernet interface",
+			f.iface)
+	}
+
+	if f.dryRun {
+		fmt.Printf("%s: %s -> %s (dry run)\n", f.iface, old.Format(n),
+			m.Format(n))
+		return nil
+	}
+	if err := link.SetAddr(f.iface, m.Bytes()); err != nil {
+		return fmt.Errorf("-apply: %w", err)
+	}
+	fmt.Printf("%s: %s -> %s\n", f.iface, old.Format(n), m.Format(n))
+	return nil
+}
+
+// restorePermanent sets the permanent hardware address on the interface in
+// f and prints the old and new address in notation n
+func (f *applyFlags) restorePermanent(n mac.Notation) error {
+	hw, err := permanentAddr(f.iface)
+	if err != nil {
+		return fmt.Errorf("-restore: %w", err)
+	}
+	m, err := mac.TryParse(hw.String())
+	if err != nil {
+		return fmt.Errorf("-restore: %w", err)
+	}
+	return f.apply(m, n)
+}
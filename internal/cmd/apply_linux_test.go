@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"net"
+	"os/exec"
+	"runtime"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+// TestRunMACApplyRestore tests -apply and -restore on a veth link in a new
+// network namespace, it requires CAP_NET_ADMIN and the ip command
+func TestRunMACApplyRestore(t *testing.T) {
+	// the namespace belongs to this thread only, it is never unlocked so
+	// the thread exits with the test
+	runtime.LockOSThread()
+	if err := syscall.Unshare(syscall.CLONE_NEWNET); err != nil {
+		t.Skipf("cannot create network namespace: %v", err)
+	}
+	const name = "ratest0"
+	out, err := exec.Command("ip", "link", "add", name, "type", "veth",
+		"peer", "name", "ratest1").CombinedOutput()
+	if err != nil {
+		t.Skipf("cannot create veth link: %v: %s", err, out)
+	}
+	hwAddr := func() string {
+		ifi, err := net.InterfaceByName(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ifi.HardwareAddr.String()
+	}
+
+	// veth links do not have a permanent address
+	code, _, stderr := captureRun(t, "mac", "-apply", name, "-restore")
+	if code != exitError || !strings.Contains(stderr, "no permanent") {
+		t.Errorf("got exit code %d %q, want %d", code, stderr, exitError)
+	}
+
+	// change the address
+	code, stdout, stderr := captureRun(t, "mac", "-apply", name, "-prefix",
+		"02:00:5e:10:00:01/48")
+	if code != 0 {
+		t.Fatalf("got exit code %d: %s", code, stderr)
+	}
+	if got := hwAddr(); got != "02:00:5e:10:00:01" ||
+		!strings.HasSuffix(stdout, " -> 02:00:5e:10:00:01\n") {
+		t.Errorf("got %s %q, want 02:00:5e:10:00:01", got, stdout)
+	}
+
+	// restore the permanent address
+	perm, _ := net.ParseMAC("00:00:5e:00:53:01")
+	defer func(f func(string) (net.HardwareAddr, error)) {
+		permanentAddr = f
+	}(permanentAddr)
+	permanentAddr = func(string) (net.HardwareAddr, error) {
+		return perm, nil
+	}
+	code, stdout, stderr = captureRun(t, "mac", "-apply", name, "-restore")
+	if code != 0 {
+		t.Fatalf("got exit code %d: %s", code, stderr)
+	}
+	if got := hwAddr(); got != perm.String() ||
+		stdout != name+": 02:00:5e:10:00:01 -> 00:00:5e:00:53:01\n" {
+		t.Errorf("got %s %q, want %s", got, stdout, perm)
+	}
+
+	// generation flags are not allowed with -restore
+	for _, args := range [][]string{
+		{"-local"},
+		{"-oui", "52:54:00"},
+		{"-n", "1"},
+		{"-seed", "1"},
+	} {
+		args = append([]string{"mac", "-apply", name, "-restore"},
+			args...)
+		code, _, stderr := captureRun(t, args...)
+		if code != exitUsage || !strings.Contains(stderr, "exclusive") {
+			t.Errorf("%q: got exit code %d %q, want %d", args, code,
+				stderr, exitUsage)
+		}
+	}
+}
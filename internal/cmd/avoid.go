@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/hwipl/random-addr/internal/hostinfo"
+	"github.com/hwipl/random-addr/mac"
+)
+
+// avoidFlags are the command line flags for avoiding collisions with known
+// MAC addresses
+type avoidFlags struct {
+	local bool
+	files []string
+
+	// known contains the known addresses, it is nil if no addresses are
+	// avoided
+	known *mac.Set
+
+	// rejected is the number of rejected candidates
+	rejected int
+}
+
+// addFlags adds the avoid flags to fs
+func (f *avoidFlags) addFlags(fs *flag.FlagSet) {
+	fs.BoolVar(&f.local, "avoid-local", false,
+		"do not generate addresses of local interfaces or in the ARP "+
+			"and IPv6 neighbor caches")
+	fs.Func("avoid-file", "do not generate addresses listed in `file`, "+
+		"one address per line, can be specified multiple times",
+		func(s string) error {
+			f.files = append(f.files, s)
+			return nil
+		})
+}
+
+// addLocal adds the addresses of the local interfaces and neighbors to the
+// known addresses, addresses other than MAC addresses are ignored
+func (f *avoidFlags) addLocal() error {
+	var hws []string
+	ifaces, err := hostinfo.Interfaces()
+	if err != nil {
+		return err
+	}
+	for _, iface := range ifaces {
+		hws = append(hws, iface.MAC)
+	}
+	arp, err := hostinfo.ARP()
+	if err != nil {
+		return err
+	}
+	ndp, err := hostinfo.NDP()
+	if err != nil {
+		return err
+	}
+	for _, n := range append(arp, ndp...) {
+		hws = append(hws, n.MAC)
+	}
+	for _, hw := range hws {
+		if m, err := mac.TryParse(hw); err == nil {
+			f.known.Add(m)
+		}
+	}
+	return nil
+}
+
+// load loads the known addresses configured in f
+func (f *avoidFlags) load() error {
+	if !f.local && len(f.files) == 0 {
+		return nil
+	}
+	f.known = mac.NewSet()
+	if f.local {
+		if err := f.addLocal(); err != nil {
+			return fmt.Errorf("-avoid-local: %w", err)
+		}
+	}
+	for _, file := range f.files {
+		if err := f.known.LoadFile(file); err != nil {
+			return fmt.Errorf("-avoid-file: %w", err)
+		}
+	}
+	return nil
+}
+
+// random calls random until it returns an address that is not known and
+// counts the rejected candidates
+func (f *avoidFlags) random(random func() (*mac.MAC, error)) (*mac.MAC,
+	error) {
+	if f.known == nil {
+		return random()
+	}
+	m, rejected, err := f.known.Avoid(random)
+	f.rejected += rejected
+	return m, err
+}
+
+// report prints the number of rejected candidates to stderr if known
+// addresses are avoided
+func (f *avoidFlags) report() {
+	if f.known == nil {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "rejected: %d candidates, %d known addresses\n",
+		f.rejected, f.known.Len())
+}
@@ -1,59 +1,243 @@
 package cmd
 
 import (
+	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 
-	"github.com/hwipl/random-addr/internal/ipv4"
-	"github.com/hwipl/random-addr/internal/ipv6"
-	"github.com/hwipl/random-addr/internal/mac"
+	"github.com/hwipl/random-addr/addr"
 )
 
-// printMAC prints m
-func printMAC(m *mac.MAC) {
-	fmt.Println("Random MAC Address")
-	fmt.Println("==================")
-	fmt.Println()
-	fmt.Println(m)
+const (
+	// progName is the name of the program
+	progName = "random-addr"
+
+	// exitError is the exit code on runtime errors
+	exitError = 1
+
+	// exitUsage is the exit code on usage errors
+	exitUsage = 2
+)
+
+// usageError is an error caused by invalid command line usage
+type usageError struct {
+	err error
+
+	// reported is set if the error was already printed by a flag set
+	reported bool
+}
+
+// Error returns e as string
+func (e *usageError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying error of e
+func (e *usageError) Unwrap() error {
+	return e.err
+}
+
+// usageErrorf returns a new usage error with format and args
+func usageErrorf(format string, args ...any) error {
+	return &usageError{err: fmt.Errorf(format, args...)}
+}
+
+// command is a subcommand
+type command struct {
+	// name is the name of the command
+	name string
+
+	// args describes the positional arguments of the command
+	args string
+
+	// desc is a short description of the command
+	desc string
+
+	// run runs the command with its command line arguments
+	run func(args []string) error
+}
+
+// commands returns all subcommands
+func commands() []*command {
+	return []*command{
+		{"mac", "", "generate a random MAC address", runMAC},
+		{"ipv4", "", "generate a random IPv4 address", runIPv4},
+		{"ipv6", "", "generate a random IPv6 address", runIPv6},
+		{"explain", "<addr>[/prefix]...", "explain MAC, EUI-64, IPv4 and IPv6 addresses", runExplain},
+		{"inspect", "", "inspect the addresses of local interfaces and neighbors", runInspect},
+		{"help", "[command]", "show help for a command", runHelp},
+	}
+}
+
+// defaultCommand is the command run if no command is given
+const defaultCommand = "mac"
+
+// findCommand returns the command with name or nil if it does not exist
+func findCommand(name string) *command {
+	for _, c := range commands() {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// printUsage prints the top-level usage to w
+func printUsage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: %s [command] [flags] [args]\n", progName)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, c := range commands() {
+		fmt.Fprintf(w, "  %-10s%s\n", c.name, c.desc)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "If no command is given, %s is run.\n", defaultCommand)
+	fmt.Fprintf(w, "Run '%s help <command>' for help on a command.\n", progName)
+}
+
+// newFlagSet returns a new flag set for the command with name
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(progName+" "+name, flag.ContinueOnError)
+	fs.Usage = func() {
+		w := fs.Output()
+		c := findCommand(name)
+		fmt.Fprintf(w, "Usage: %s %s [flags]", progName, c.name)
+		if c.args != "" {
+			fmt.Fprintf(w, " %s", c.args)
+		}
+		fmt.Fprintln(w)
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "%s.\n", strings.ToUpper(c.desc[:1])+c.desc[1:])
+		hasFlags := false
+		fs.VisitAll(func(*flag.Flag) { hasFlags = true })
+		if hasFlags {
+			fmt.Fprintln(w)
+			fmt.Fprintln(w, "Flags:")
+			fs.PrintDefaults()
+		}
+	}
+	return fs
+}
+
+// parseFlags parses args with fs, it returns a usage error on invalid flags
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return &usageError{err: err, reported: true}
+	}
+	return nil
+}
+
+// parseNoArgs parses args with fs and makes sure there are no positional
+// arguments left
+func parseNoArgs(fs *flag.FlagSet, args []string) error {
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return usageErrorf("unexpected argument %q", fs.Arg(0))
+	}
+	return nil
+}
+
+// printHeader prints title as an underlined header
+func printHeader(title string) {
+	fmt.Println(title)
+	fmt.Println(strings.Repeat("=", len(title)))
 	fmt.Println()
-	fmt.Println("Details")
-	fmt.Println("=======")
+}
+
+// printAddr prints the address a with title
+func printAddr(title string, a addr.Address) {
+	printHeader(title)
+	fmt.Println(a)
 	fmt.Println()
-	fmt.Println(m.Explain())
+	printHeader("Details")
+	fmt.Println(strings.TrimRight(a.Explain(), "\n"))
 	fmt.Println()
-	fmt.Println(m.Table())
+	fmt.Println(a.Table())
 	fmt.Println()
 }
 
-// runMAC runs the mac subcommand
-func runMAC() {
-	m := mac.Random()
-	printMAC(m)
+// printAddrs prints addrs in the output format in o, a single address in
+// text format is printed with all details and title
+func printAddrs[T addr.Address](o *formatFlags, title string, addrs []T) error {
+	if !o.text() {
+		return o.writeRecords(os.Stdout, toRecords(addrs))
+	}
+	if len(addrs) == 1 {
+		printAddr(title, addrs[0])
+		return nil
+	}
+	for _, a := range addrs {
+		fmt.Println(a)
+	}
+	return nil
 }
 
-// runIPv4 runs the ipv4 subcommand
-func runIPv4() {
-	ip := ipv4.Random()
-	fmt.Println(ip)
+// runHelp runs the help subcommand
+func runHelp(args []string) error {
+	fs := newFlagSet("help")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		printUsage(os.Stdout)
+		return nil
+	}
+	c := findCommand(fs.Arg(0))
+	if c == nil {
+		return usageErrorf("unknown command %q", fs.Arg(0))
+	}
+	return c.run([]string{"-h"})
 }
 
-// runIPv6 runs the ipv6 subcommand
-func runIPv6() {
-	ip := ipv6.Random()
-	fmt.Println(ip)
+// run runs the command in args and returns the exit code
+func run(args []string) int {
+	name := defaultCommand
+	if len(args) > 0 {
+		switch args[0] {
+		case "-h", "-help", "--help":
+			printUsage(os.Stdout)
+			return 0
+		}
+		if !strings.HasPrefix(args[0], "-") {
+			name = args[0]
+			args = args[1:]
+		}
+	}
+
+	var err error
+	if c := findCommand(name); c != nil {
+		err = c.run(args)
+	} else {
+		err = usageErrorf("unknown command %q", name)
+	}
+
+	var uerr *usageError
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.As(err, &uerr) && uerr.reported:
+		return exitUsage
+	case errors.As(err, &uerr):
+		fmt.Fprintf(os.Stderr, "%s: %v\n", progName, err)
+		fmt.Fprintf(os.Stderr, "Run '%s help' for usage.\n", progName)
+		return exitUsage
+	default:
+		fmt.Fprintf(os.Stderr, "%s: %v\n", progName, err)
+		return exitError
+	}
 }
 
 // Run is the main entry point
 func Run() {
-	flag.Parse()
-	switch flag.Arg(0) {
-	case "mac":
-		runMAC()
-	case "ipv4":
-		runIPv4()
-	case "ipv6":
-		runIPv6()
-	default:
-		runMAC()
-	}
+	os.Exit(run(os.Args[1:]))
 }
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+// captureRun runs the command in args and returns its exit code, stdout
+// and stderr
+func captureRun(t *testing.T, args ...string) (int, string, string) {
+	t.Helper()
+	stdout, err := os.CreateTemp(t.TempDir(), "stdout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stderr, err := os.CreateTemp(t.TempDir(), "stderr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldStdout, oldStderr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = stdout, stderr
+	code := run(args)
+	os.Stdout, os.Stderr = oldStdout, oldStderr
+
+	read := func(f *os.File) string {
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+		b, err := io.ReadAll(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Close()
+		return string(b)
+	}
+	return code, read(stdout), read(stderr)
+}
+
+// TestRun tests the exit codes and output of run
+func TestRun(t *testing.T) {
+	for _, test := range []struct {
+		args   []string
+		code   int
+		stdout string
+		stderr string
+	}{
+		// default command
+		{nil, 0, "Random MAC Address", ""},
+		{[]string{"-seed", "1"}, 0, "Random MAC Address", ""},
+
+		// help
+		{[]string{"help"}, 0, "Commands:", ""},
+		{[]string{"-h"}, 0, "Commands:", ""},
+		{[]string{"--help"}, 0, "Commands:", ""},
+		{[]string{"mac", "-h"}, 0, "", "Usage: random-addr mac [flags]"},
+		{[]string{"help", "ipv6"}, 0, "", "Usage: random-addr ipv6 [flags]"},
+
+		// success
+		{[]string{"ipv4", "-seed", "1", "-n", "2"}, 0, ".", ""},
+		{[]string{"explain", "00:11:22:33:44:55"}, 0, "MAC Address", ""},
+
+		// usage errors
+		{[]string{"bogus"}, exitUsage, "", `unknown command "bogus"`},
+		{[]string{"help", "bogus"}, exitUsage, "",
+			`unknown command "bogus"`},
+		{[]string{"mac", "-bogus"}, exitUsage, "",
+			"flag provided but not defined: -bogus"},
+		{[]string{"mac", "extra"}, exitUsage, "",
+			`unexpected argument "extra"`},
+		{[]string{"ipv4", "-n", "0"}, exitUsage, "",
+			"-n must be at least 1"},
+		{[]string{"explain"}, exitUsage, "", "missing address"},
+
+		// runtime errors
+		{[]string{"explain", "zz"}, exitError, "", `cannot parse "zz"`},
+		{[]string{"mac", "-prefix", "52:54:00:00:00:00/47", "-n", "3",
+			"-unique"}, exitError, "", "address space too small"},
+	} {
+		code, stdout, stderr := captureRun(t, test.args...)
+		if code != test.code {
+			t.Errorf("%q: got exit code %d, want %d", test.args, code,
+				test.code)
+		}
+		if !strings.Contains(stdout, test.stdout) {
+			t.Errorf("%q: got stdout %q, want %q", test.args, stdout,
+				test.stdout)
+		}
+		if !strings.Contains(stderr, test.stderr) {
+			t.Errorf("%q: got stderr %q, want %q", test.args, stderr,
+				test.stderr)
+		}
+		if test.stdout == "" && stdout != "" {
+			t.Errorf("%q: got stdout %q, want none", test.args, stdout)
+		}
+		if test.stderr == "" && stderr != "" {
+			t.Errorf("%q: got stderr %q, want none", test.args, stderr)
+		}
+	}
+
+	// usage errors reported by the flag set are not repeated
+	_, _, stderr := captureRun(t, "mac", "-bogus")
+	if strings.Contains(stderr, "random-addr: ") {
+		t.Errorf("got repeated usage error %q", stderr)
+	}
+}
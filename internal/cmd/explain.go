@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hwipl/random-addr/addr"
+	"github.com/hwipl/random-addr/ipv4"
+	"github.com/hwipl/random-addr/mac"
+)
+
+// runExplain runs the explain subcommand with the addresses in args
+func runExplain(args []string) error {
+	fs := newFlagSet("explain")
+	wire := fs.Bool("wire", false,
+		"show MAC addresses in transmission order on Ethernet")
+	reversed := fs.Bool("bit-reversed", false,
+		"read MAC addresses in bit-reversed (non-canonical) form, "+
+			"e.g., from Token Ring or FDDI tools")
+	parseAs := fs.String("parse-as", "",
+		"read all addresses as MAC addresses in `form`: "+
+			strings.Join(mac.Forms(), ", "))
+	var forms string
+	addFormsFlag(fs, &forms)
+	o := &formatFlags{}
+	o.addFlags(fs)
+	rf := &registryFlags{}
+	rf.addFlags(fs)
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := o.check(); err != nil {
+		return err
+	}
+	if err := rf.load(); err != nil {
+		return err
+	}
+	formValues, err := parseForms(forms)
+	if err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return usageErrorf("explain: missing address")
+	}
+	if *parseAs != "" {
+		return explainForm(fs.Args(), *parseAs, *reversed, *wire,
+			formValues, o)
+	}
+
+	var addrs []addr.Address
+	var titles []string
+	for _, s := range fs.Args() {
+		f, err := addr.Lookup(addr.Detect(s))
+		if err != nil {
+			return fmt.Errorf("explain: %w", err)
+		}
+		a, err := f.Parse(s)
+		if err != nil {
+			return fmt.Errorf("explain: %w", err)
+		}
+		if m, ok := a.(*mac.MAC); ok && *reversed {
+			a = m.BitReversed()
+		}
+		addrs = append(addrs, a)
+		titles = append(titles, f.Title+" Address")
+	}
+	if !o.text() {
+		for i, a := range addrs {
+			if m, ok := a.(*mac.MAC); ok {
+				addrs[i] = notatedMAC{m, mac.NotationColon,
+					formValues}
+			}
+		}
+		return o.writeRecords(os.Stdout, toRecords(addrs))
+	}
+	for i, a := range addrs {
+		if m, ok := a.(*mac.MAC); ok {
+			printMAC(titles[i], m, *wire, formValues)
+			continue
+		}
+		printAddr(titles[i], a)
+	}
+	return nil
+}
+
+// explainForm explains the MAC addresses in form name in args
+func explainForm(args []string, name string, reversed, wire bool,
+	forms []mac.Form, o *formatFlags) error {
+	form, err := mac.LookupForm(name)
+	if err != nil {
+		return usageErrorf("-parse-as: %w", err)
+	}
+	var macs []notatedMAC
+	for _, s := range args {
+		m, err := mac.TryParseForm(s, form)
+		if err != nil {
+			return fmt.Errorf("explain: %w", err)
+		}
+		if reversed {
+			m = m.BitReversed()
+		}
+		macs = append(macs, notatedMAC{m, mac.NotationColon, forms})
+	}
+	if !o.text() {
+		return o.writeRecords(os.Stdout, toRecords(macs))
+	}
+	for _, m := range macs {
+		printMAC("MAC Address", m.MAC, wire, forms)
+	}
+	return nil
+}
+
+// printMAC prints m with title, its additional forms, its transmission
+// order if wire is set and its IPv4 multicast groups
+func printMAC(title string, m *mac.MAC, wire bool, forms []mac.Form) {
+	printAddr(title, notatedMAC{m, mac.NotationColon, forms})
+	if wire {
+		printHeader("Transmission Order")
+		fmt.Println(m.ExplainWire())
+		fmt.Println()
+	}
+	printMulticastGroups(m)
+}
+
+// printMulticastGroups prints the IPv4 multicast groups mapped to m if m is
+// an IPv4 multicast MAC address
+func printMulticastGroups(m *mac.MAC) {
+	groups, err := ipv4.MulticastGroups(m)
+	if err != nil {
+		return
+	}
+	printHeader("IPv4 Multicast Groups")
+	printList(groups)
+	fmt.Println()
+}
@@ -0,0 +1,326 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hwipl/random-addr/addr"
+	"github.com/hwipl/random-addr/ipv4"
+	"github.com/hwipl/random-addr/ipv6"
+	"github.com/hwipl/random-addr/mac"
+)
+
+// recordVersion is the version of the structured output records, it must be
+// increased whenever fields are renamed, removed or change their meaning
+const recordVersion = 1
+
+// output formats
+const (
+	formatText  = "text"
+	formatJSON  = "json"
+	formatJSONL = "jsonl"
+	formatCSV   = "csv"
+	formatYAML  = "yaml"
+)
+
+// formats are all supported output formats
+var formats = []string{formatText, formatJSON, formatJSONL, formatCSV,
+	formatYAML}
+
+// field is a named field in a record
+type field struct {
+	name  string
+	value any
+}
+
+// record is an address as an ordered list of fields
+type record []field
+
+// MarshalJSON returns r as JSON object with fields in order
+func (r record) MarshalJSON() ([]byte, error) {
+	b := []byte{'{'}
+	for i, f := range r {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = strconv.AppendQuote(b, f.name)
+		b = append(b, ':')
+		v, err := json.Marshal(f.value)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, v...)
+	}
+	return append(b, '}'), nil
+}
+
+// newRecord returns a new record for an address of family with fields
+func newRecord(family string, fields ...field) record {
+	r := record{{"version", recordVersion}, {"family", family}}
+	return append(r, fields...)
+}
+
+// macRecord returns m as record
+func macRecord(m *mac.MAC) record {
+	return newRecord("mac",
+		field{"hex", m.Hex()},
+		field{"oui", m.OUI()},
+		field{"nic", m.NIC()},
+		field{"vendor", m.Vendor()},
+		field{"binary", m.Binary()},
+		field{"bit_reversed", m.BitReversed().String()},
+		field{"ul", m.UL()},
+		field{"ig", m.IG()},
+		field{"slap", m.Quadrant().String()},
+		field{"well_known", m.WellKnown()},
+		field{"preset", m.Preset()},
+		field{"type", m.Type()},
+		field{"integer", m.Uint64()},
+		field{"address", m.String()},
+	)
+}
+
+// eui64Record returns e as record
+func eui64Record(e *mac.EUI64) record {
+	return newRecord("eui64",
+		field{"hex", e.Hex()},
+		field{"oui", e.OUI()},
+		field{"extension", e.Extension()},
+		field{"vendor", e.Vendor()},
+		field{"binary", e.Binary()},
+		field{"ul", e.UL()},
+		field{"ig", e.IG()},
+		field{"type", e.Type()},
+	)
+}
+
+// multicastMAC returns the MAC address of the multicast group ip as string
+// or an empty string if ip is not a multicast address
+func multicastMAC(ip interface{ MulticastMAC() (*mac.MAC, error) }) string {
+	m, err := ip.MulticastMAC()
+	if err != nil {
+		return ""
+	}
+	return m.String()
+}
+
+// ipv4Record returns ip as record
+func ipv4Record(ip *ipv4.IPv4) record {
+	return newRecord("ipv4",
+		field{"decimal", ip.Decimal()},
+		field{"prefix", ip.Prefix().String()},
+		field{"prefix_length", ip.Prefix().Bits()},
+		field{"network", ip.Network()},
+		field{"host", ip.Host()},
+		field{"binary", ip.Binary()},
+		field{"type", ip.Type()},
+		field{"multicast_mac", multicastMAC(ip)},
+	)
+}
+
+// ipv6Record returns ip as record
+func ipv6Record(ip *ipv6.IPv6) record {
+	return newRecord("ipv6",
+		field{"hex", ip.Hex()},
+		field{"prefix", ip.Prefix().String()},
+		field{"prefix_length", ip.Prefix().Bits()},
+		field{"network", ip.Network()},
+		field{"subnet", ip.Subnet()},
+		field{"iid", ip.IID()},
+		field{"binary", ip.Binary()},
+		field{"type", ip.Type()},
+		field{"multicast_mac", multicastMAC(ip)},
+	)
+}
+
+// addrRecord returns a as record
+func addrRecord(a addr.Address) record {
+	switch a := a.(type) {
+	case *mac.MAC:
+		return macRecord(a)
+	case notatedMAC:
+		return a.record()
+	case *mac.EUI64:
+		return eui64Record(a)
+	case *ipv4.IPv4:
+		return ipv4Record(a)
+	case *ipv6.IPv6:
+		return ipv6Record(a)
+	}
+	return newRecord("unknown",
+		field{"string", a.String()},
+		field{"binary", a.Binary()},
+		field{"type", a.Type()},
+	)
+}
+
+// formatFlags are the command line flags for the output format
+type formatFlags struct {
+	format string
+}
+
+// addFlags adds the format flags to fs
+func (f *formatFlags) addFlags(fs *flag.FlagSet) {
+	fs.StringVar(&f.format, "format", formatText, "set output `format`: "+
+		strings.Join(formats, ", "))
+}
+
+// check checks the format flags in f
+func (f *formatFlags) check() error {
+	for _, format := range formats {
+		if f.format == format {
+			return nil
+		}
+	}
+	return usageErrorf("unknown format %q", f.format)
+}
+
+// text returns whether the output format is human readable text
+func (f *formatFlags) text() bool {
+	return f.format == formatText
+}
+
+// writeJSON writes records to w as JSON array
+func writeJSON(w io.Writer, records []record) error {
+	if records == nil {
+		records = []record{}
+	}
+	b, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", b)
+	return err
+}
+
+// writeJSONL writes records to w as JSON lines
+func writeJSONL(w io.Writer, records []record) error {
+	for _, r := range records {
+		b, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "%s\n", b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeCSV writes records to w as CSV with a header line, the columns are
+// the union of all record fields
+func writeCSV(w io.Writer, records []record) error {
+	var header []string
+	columns := make(map[string]int)
+	for _, r := range records {
+		for _, f := range r {
+			if _, ok := columns[f.name]; !ok {
+				columns[f.name] = len(header)
+				header = append(header, f.name)
+			}
+		}
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, r := range records {
+		row := make([]string, len(header))
+		for _, f := range r {
+			row[columns[f.name]] = fmt.Sprint(f.value)
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// yamlQuote returns s as double-quoted YAML scalar, printable characters
+// are kept, all others use YAML escape sequences, invalid UTF-8 is replaced
+// with U+FFFD
+func yamlQuote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range strings.ToValidUTF8(s, string(utf8.RuneError)) {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case unicode.IsPrint(r):
+			b.WriteRune(r)
+		case r <= 0xff:
+			fmt.Fprintf(&b, `\x%02x`, r)
+		case r <= 0xffff:
+			fmt.Fprintf(&b, `\u%04x`, r)
+		default:
+			fmt.Fprintf(&b, `\U%08x`, r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// writeYAML writes records to w as YAML sequence of mappings
+func writeYAML(w io.Writer, records []record) error {
+	if len(records) == 0 {
+		_, err := fmt.Fprintln(w, "[]")
+		return err
+	}
+	for _, r := range records {
+		for i, f := range r {
+			indent := "  "
+			if i == 0 {
+				indent = "- "
+			}
+			v := fmt.Sprint(f.value)
+			if s, ok := f.value.(string); ok {
+				v = yamlQuote(s)
+			}
+			if _, err := fmt.Fprintf(w, "%s%s: %s\n", indent, f.name,
+				v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// writeRecords writes records to w in the output format in f
+func (f *formatFlags) writeRecords(w io.Writer, records []record) error {
+	switch f.format {
+	case formatJSON:
+		return writeJSON(w, records)
+	case formatJSONL:
+		return writeJSONL(w, records)
+	case formatCSV:
+		return writeCSV(w, records)
+	case formatYAML:
+		return writeYAML(w, records)
+	}
+	return fmt.Errorf("cannot write records in format %q", f.format)
+}
+
+// toRecords converts addrs to records
+func toRecords[T addr.Address](addrs []T) []record {
+	records := make([]record, 0, len(addrs))
+	for _, a := range addrs {
+		records = append(records, addrRecord(a))
+	}
+	return records
+}
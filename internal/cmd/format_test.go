@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hwipl/random-addr/ipv4"
+	"github.com/hwipl/random-addr/ipv6"
+	"github.com/hwipl/random-addr/mac"
+)
+
+// update updates the golden files in testdata
+var update = flag.Bool("update", false, "update golden files")
+
+// testRecords returns records of all address families and a record with
+// strings that need quoting
+func testRecords() []record {
+	return []record{
+		macRecord(mac.Parse("02:00:5e:10:00:01")),
+		eui64Record(mac.ParseEUI64("02:00:5e:ff:fe:10:00:01")),
+		ipv4Record(ipv4.Parse("192.0.2.1/24")),
+		ipv6Record(ipv6.Parse("2001:db8::1/64")),
+		newRecord("unknown",
+			field{"string", "a \"quoted\" \\ string, with comma"},
+			field{"control", "tab\tnewline\nbell\a"},
+			field{"unicode", "Bücher \u2028 \U0001f600"},
+			field{"number", 42},
+			field{"bool", true},
+		),
+	}
+}
+
+// TestWriteRecords tests the output of all structured formats against the
+// golden files in testdata, run with -update to update them
+func TestWriteRecords(t *testing.T) {
+	for _, format := range formats {
+		if format == formatText {
+			continue
+		}
+		f := &formatFlags{format: format}
+		var b bytes.Buffer
+		if err := f.writeRecords(&b, testRecords()); err != nil {
+			t.Fatal(err)
+		}
+
+		golden := filepath.Join("testdata", "records."+format)
+		if *update {
+			if err := os.WriteFile(golden, b.Bytes(), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		want, err := os.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(b.Bytes(), want) {
+			t.Errorf("%s: got\n%s\nwant\n%s", format, b.Bytes(), want)
+		}
+	}
+}
+
+// TestWriteRecordsEmpty tests the output of all structured formats without
+// records
+func TestWriteRecordsEmpty(t *testing.T) {
+	for format, want := range map[string]string{
+		formatJSON:  "[]\n",
+		formatJSONL: "",
+		formatCSV:   "\n",
+		formatYAML:  "[]\n",
+	} {
+		f := &formatFlags{format: format}
+		var b bytes.Buffer
+		if err := f.writeRecords(&b, nil); err != nil {
+			t.Fatal(err)
+		}
+		if got := b.String(); got != want {
+			t.Errorf("%s: got %q, want %q", format, got, want)
+		}
+	}
+}
+
+// TestYAMLQuote tests yamlQuote
+func TestYAMLQuote(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want string
+	}{
+		{"", `""`},
+		{"plain", `"plain"`},
+		{`a "b" \c`, `"a \"b\" \\c"`},
+		{"\n\t\r", `"\n\t\r"`},
+		{"\x00\x7f\u0085", `"\x00\x7f\x85"`},
+		{"ä\u2028\U000e0001", `"ä\u2028\U000e0001"`},
+		{"\xff", "\"�\""},
+	} {
+		if got := yamlQuote(test.s); got != test.want {
+			t.Errorf("%q: got %s, want %s", test.s, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	mrand "math/rand/v2"
+	"os"
+	"strconv"
+
+	"github.com/hwipl/random-addr/internal/randsrc"
+)
+
+// errAddressSpace is returned if there are not enough unique addresses
+var errAddressSpace = errors.New("address space too small")
+
+// seedValue is a flag value for the seed of the random number generator
+type seedValue struct {
+	seed uint64
+	set  bool
+}
+
+// String returns s as string
+func (s *seedValue) String() string {
+	if s == nil || !s.set {
+		return ""
+	}
+	return strconv.FormatUint(s.seed, 10)
+}
+
+// Set sets s to the seed in v
+func (s *seedValue) Set(v string) error {
+	seed, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return errors.New("invalid seed")
+	}
+	s.seed = seed
+	s.set = true
+	return nil
+}
+
+// genFlags are the command line flags for generating addresses
+type genFlags struct {
+	n         int
+	unique    bool
+	seed      seedValue
+	printSeed bool
+
+	// name, secret and secretFile configure keyed derivation
+	name       string
+	secret     string
+	secretFile string
+}
+
+// addFlags adds the generation flags to fs
+func (f *genFlags) addFlags(fs *flag.FlagSet) {
+	fs.IntVar(&f.n, "n", 1, "generate `N` addresses, "+
+		"if N is greater than 1, only the addresses are printed")
+	fs.BoolVar(&f.unique, "unique", false,
+		"make sure generated addresses do not repeat")
+	fs.Var(&f.seed, "seed", "use a deterministic random number generator "+
+		"with `seed` instead of crypto/rand")
+	fs.BoolVar(&f.printSeed, "print-seed", false,
+		"print the seed to stderr, pick a random seed if -seed is not set")
+	fs.StringVar(&f.name, "name", "",
+		"derive the address from instance `name` and the secret, "+
+			"the same name and secret always result in the same address")
+	fs.StringVar(&f.secret, "secret", "",
+		"set the `secret` for -name")
+	fs.StringVar(&f.secretFile, "secret-file", "",
+		"read the secret for -name from `file`")
+}
+
+// keyedSecret returns the secret for keyed derivation configured in f
+func (f *genFlags) keyedSecret() ([]byte, error) {
+	if f.secretFile == "" {
+		return []byte(f.secret), nil
+	}
+	b, err := os.ReadFile(f.secretFile)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(b, "\r\n"), nil
+}
+
+// reader returns the reader of random bytes configured in f, family
+// separates keyed derivations of the same name for different families
+func (f *genFlags) reader(family string) (io.Reader, error) {
+	if f.name != "" {
+		secret, err := f.keyedSecret()
+		if err != nil {
+			return nil, err
+		}
+		if len(secret) == 0 {
+			return nil, usageErrorf("-name requires a non-empty secret")
+		}
+		return randsrc.Keyed(secret, family, f.name), nil
+	}
+	if !f.seed.set && !f.printSeed {
+		return rand.Reader, nil
+	}
+	if !f.seed.set {
+		if err := binary.Read(rand.Reader, binary.LittleEndian,
+			&f.seed.seed); err != nil {
+			return nil, err
+		}
+		f.seed.set = true
+	}
+	if f.printSeed {
+		fmt.Fprintf(os.Stderr, "seed: %d\n", f.seed.seed)
+	}
+
+	var seed [32]byte
+	binary.LittleEndian.PutUint64(seed[:], f.seed.seed)
+	return mrand.NewChaCha8(seed), nil
+}
+
+// spaceSize returns the number of addresses in an address space with
+// freeBits random bits, it is capped at the maximum uint64
+func spaceSize(freeBits int) uint64 {
+	if freeBits >= 64 {
+		return ^uint64(0)
+	}
+	return 1 << freeBits
+}
+
+// check checks the generation flags in f against an address space with
+// size addresses
+func (f *genFlags) check(size uint64) error {
+	if f.n < 1 {
+		return usageErrorf("-n must be at least 1")
+	}
+	switch {
+	case f.name == "" && (f.secret != "" || f.secretFile != ""):
+		return usageErrorf("-secret and -secret-file require -name")
+	case f.name == "":
+	case f.seed.set || f.printSeed:
+		return usageErrorf("-name and -seed are exclusive")
+	case f.secret != "" && f.secretFile != "":
+		return usageErrorf("-secret and -secret-file are exclusive")
+	case f.secret == "" && f.secretFile == "":
+		return usageErrorf("-name requires -secret or -secret-file")
+	}
+	return f.checkSpace(size, 0)
+}
+
+// checkSpace checks if the address space with size addresses is large
+// enough for the unique addresses in f if the excluded addresses are not
+// generated
+func (f *genFlags) checkSpace(size, excluded uint64) error {
+	if !f.unique {
+		return nil
+	}
+	size -= min(size, excluded)
+	if uint64(f.n) > size {
+		return fmt.Errorf("%w: cannot generate %d unique addresses, "+
+			"constraints allow only %d", errAddressSpace, f.n, size)
+	}
+	return nil
+}
+
+// maxDuplicates returns the maximum number of consecutive duplicates when
+// generating n unique addresses, even if the address space contains only n
+// addresses, the chance of reaching it is below e^-20
+func maxDuplicates(n int) int {
+	return 1000 + 20*n
+}
+
+// generate calls gen to generate the addresses configured in f, with unique
+// addresses it returns errAddressSpace if gen repeatedly returns duplicates
+func generate[T fmt.Stringer](f *genFlags, gen func() (T, error)) ([]T, error) {
+	addrs := make([]T, 0, f.n)
+	seen := make(map[string]bool)
+	duplicates := 0
+	for len(addrs) < f.n {
+		a, err := gen()
+		if err != nil {
+			return nil, err
+		}
+		if f.unique {
+			if seen[a.String()] {
+				duplicates++
+				if duplicates > maxDuplicates(f.n) {
+					return nil, fmt.Errorf("%w: cannot generate "+
+						"%d unique addresses, found only %d",
+						errAddressSpace, f.n, len(addrs))
+				}
+				continue
+			}
+			seen[a.String()] = true
+			duplicates = 0
+		}
+		addrs = append(addrs, a)
+	}
+	return addrs, nil
+}
+
+// printList prints addrs one per line
+func printList[T fmt.Stringer](addrs []T) {
+	for _, a := range addrs {
+		fmt.Println(a)
+	}
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+// counter is a generator of n distinct addresses
+type counter struct {
+	i, n int
+}
+
+// next returns the next address of c
+func (c *counter) next() (*counter, error) {
+	c.i = (c.i + 1) % c.n
+	return &counter{i: c.i}, nil
+}
+
+// String returns c as string
+func (c *counter) String() string {
+	return strconv.Itoa(c.i)
+}
+
+// TestGenerate tests generate with unique addresses
+func TestGenerate(t *testing.T) {
+	// address space is large enough
+	f := &genFlags{n: 4, unique: true}
+	addrs, err := generate(f, (&counter{n: 4}).next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(addrs) != 4 {
+		t.Errorf("got %d addresses, want 4", len(addrs))
+	}
+
+	// address space is smaller than claimed, generate must not spin
+	f = &genFlags{n: 5, unique: true}
+	_, err = generate(f, (&counter{n: 4}).next)
+	if !errors.Is(err, errAddressSpace) {
+		t.Errorf("got %v, want %v", err, errAddressSpace)
+	}
+}
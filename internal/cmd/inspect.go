@@ -0,0 +1,202 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hwipl/random-addr/addr"
+	"github.com/hwipl/random-addr/internal/hostinfo"
+	"github.com/hwipl/random-addr/ipv4"
+	"github.com/hwipl/random-addr/ipv6"
+	"github.com/hwipl/random-addr/mac"
+)
+
+// address sources of inspect entries
+const (
+	sourceLocal    = "local"
+	sourceNeighbor = "neighbor"
+)
+
+// inspectEntry is an address found on the local host
+type inspectEntry struct {
+	// iface is the name of the interface the address belongs to
+	iface string
+
+	// source is the source of the address, local or neighbor
+	source string
+
+	// kind is the kind of the address, e.g., "MAC"
+	kind string
+
+	// a is the address
+	a addr.Address
+
+	// notes contains notable properties of the address
+	notes []string
+}
+
+// record returns e as record with the interface, source and notes inserted
+// after version and family
+func (e *inspectEntry) record() record {
+	return slices.Insert(addrRecord(e.a), 2,
+		field{"interface", e.iface},
+		field{"source", e.source},
+		field{"notes", strings.Join(e.notes, "; ")},
+	)
+}
+
+// inspectMAC returns an entry for the MAC address in s or nil if s is not a
+// MAC address or the all-zero address, e.g., of the loopback interface
+func inspectMAC(iface, source, s string) *inspectEntry {
+	m, err := mac.TryParse(s)
+	if err != nil || m.Uint64() == 0 {
+		return nil
+	}
+	e := &inspectEntry{iface: iface, source: source, kind: "MAC", a: m}
+	if m.Local() && m.Unicast() {
+		e.notes = append(e.notes, "randomized (locally administered)")
+	}
+	return e
+}
+
+// inspectIPv4 returns an entry for the IPv4 address in s
+func inspectIPv4(iface, source, s string) (*inspectEntry, error) {
+	ip, err := ipv4.TryParse(s)
+	if err != nil {
+		return nil, err
+	}
+	e := &inspectEntry{iface: iface, source: source, kind: "IPv4", a: ip}
+	if ip.LinkLocal() {
+		e.notes = append(e.notes, "link-local")
+	}
+	return e, nil
+}
+
+// inspectIPv6 returns an entry for the IPv6 address a
+func inspectIPv6(iface string, a *hostinfo.IPv6Addr) (*inspectEntry, error) {
+	ip, err := ipv6.TryParse(a.Prefix.String())
+	if err != nil {
+		return nil, err
+	}
+	e := &inspectEntry{iface: iface, source: sourceLocal, kind: "IPv6",
+		a: ip}
+	if ip.LinkLocalUnicast() {
+		e.notes = append(e.notes, "link-local")
+	}
+	if m, err := ip.EUI64MAC(); err == nil && !ip.Loopback() {
+		e.notes = append(e.notes, "EUI-64 of "+m.String())
+	}
+	if a.Temporary {
+		e.notes = append(e.notes, "temporary")
+	}
+	return e, nil
+}
+
+// inspectHost returns the entries of all interfaces and neighbors of the
+// local host grouped by interface
+func inspectHost() ([]*inspectEntry, error) {
+	ifaces, err := hostinfo.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+	neighbors, err := hostinfo.ARP()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*inspectEntry
+	for _, iface := range ifaces {
+		if e := inspectMAC(iface.Name, sourceLocal, iface.MAC); e != nil {
+			entries = append(entries, e)
+		}
+		for _, p := range iface.IPv4 {
+			e, err := inspectIPv4(iface.Name, sourceLocal, p.String())
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, e)
+		}
+		for _, a := range iface.IPv6 {
+			e, err := inspectIPv6(iface.Name, a)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, e)
+		}
+		for _, n := range neighbors {
+			if n.Device != iface.Name {
+				continue
+			}
+			e, err := inspectIPv4(n.Device, sourceNeighbor, n.IP.String())
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, e)
+			if e := inspectMAC(n.Device, sourceNeighbor, n.MAC); e != nil {
+				entries = append(entries, e)
+			}
+		}
+	}
+	return entries, nil
+}
+
+// printEntries prints entries as text grouped by interface, with explain
+// set all details of each address are printed
+func printEntries(entries []*inspectEntry, explain bool) {
+	iface := ""
+	for _, e := range entries {
+		if explain {
+			printAddr(fmt.Sprintf("%s %s %s Address", e.iface, e.source,
+				e.kind), e.a)
+			if len(e.notes) > 0 {
+				fmt.Printf("Notes: %s\n\n", strings.Join(e.notes, "; "))
+			}
+			continue
+		}
+		if e.iface != iface {
+			if iface != "" {
+				fmt.Println()
+			}
+			iface = e.iface
+			printHeader("Interface " + iface)
+		}
+		line := fmt.Sprintf("%-8s %-4s  %-40s %-30s %s", e.source, e.kind,
+			e.a, e.a.Type(), strings.Join(e.notes, "; "))
+		fmt.Println(strings.TrimRight(line, " "))
+	}
+}
+
+// runInspect runs the inspect subcommand
+func runInspect(args []string) error {
+	fs := newFlagSet("inspect")
+	explain := fs.Bool("explain", false, "show all details of each address")
+	o := &formatFlags{}
+	o.addFlags(fs)
+	rf := &registryFlags{}
+	rf.addFlags(fs)
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	if err := o.check(); err != nil {
+		return err
+	}
+	if err := rf.load(); err != nil {
+		return err
+	}
+
+	entries, err := inspectHost()
+	if err != nil {
+		return fmt.Errorf("inspect: %w", err)
+	}
+	if !o.text() {
+		records := make([]record, len(entries))
+		for i, e := range entries {
+			records[i] = e.record()
+		}
+		return o.writeRecords(os.Stdout, records)
+	}
+	printEntries(entries, *explain)
+	return nil
+}
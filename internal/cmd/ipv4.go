@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"flag"
+
+	"github.com/hwipl/random-addr/ipv4"
+)
+
+// ipv4Flags are the command line flags of the ipv4 subcommand
+type ipv4Flags struct {
+	prefix string
+}
+
+// addFlags adds the ipv4 flags to fs
+func (f *ipv4Flags) addFlags(fs *flag.FlagSet) {
+	fs.StringVar(&f.prefix, "prefix", "",
+		"generate the address inside prefix, e.g., 10.0.0.0/8")
+}
+
+// freeBits returns the number of random bits left by the constraints in f
+func (f *ipv4Flags) freeBits() int {
+	if f.prefix == "" {
+		return 32
+	}
+	p, err := ipv4.TryParse(f.prefix)
+	if err != nil {
+		// invalid prefix is reported when generating addresses
+		return 32
+	}
+	return 32 - p.Prefix().Bits()
+}
+
+// random returns a random IPv4 address with the constraints in f
+func (f *ipv4Flags) random(gen *ipv4.Generator) (*ipv4.IPv4, error) {
+	ip, err := gen.Random()
+	if err != nil {
+		return nil, err
+	}
+	if f.prefix != "" {
+		if err := ip.TrySetPrefix(f.prefix); err != nil {
+			return nil, usageErrorf("-prefix: %w", err)
+		}
+	}
+	return ip, nil
+}
+
+// runIPv4 runs the ipv4 subcommand
+func runIPv4(args []string) error {
+	fs := newFlagSet("ipv4")
+	f := &ipv4Flags{}
+	f.addFlags(fs)
+	g := &genFlags{}
+	g.addFlags(fs)
+	o := &formatFlags{}
+	o.addFlags(fs)
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	if err := o.check(); err != nil {
+		return err
+	}
+	if err := g.check(spaceSize(f.freeBits())); err != nil {
+		return err
+	}
+
+	r, err := g.reader("ipv4")
+	if err != nil {
+		return err
+	}
+	gen := ipv4.NewGenerator(r)
+	ips, err := generate(g, func() (*ipv4.IPv4, error) {
+		return f.random(gen)
+	})
+	if err != nil {
+		return err
+	}
+	return printAddrs(o, "Random IPv4 Address", ips)
+}
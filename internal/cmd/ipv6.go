@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"flag"
+
+	"github.com/hwipl/random-addr/ipv6"
+)
+
+// ipv6Flags are the command line flags of the ipv6 subcommand
+type ipv6Flags struct {
+	prefix string
+}
+
+// addFlags adds the ipv6 flags to fs
+func (f *ipv6Flags) addFlags(fs *flag.FlagSet) {
+	fs.StringVar(&f.prefix, "prefix", "",
+		"generate the address inside prefix, e.g., 2001:db8::/48")
+}
+
+// freeBits returns the number of random bits left by the constraints in f
+func (f *ipv6Flags) freeBits() int {
+	if f.prefix == "" {
+		return 128
+	}
+	p, err := ipv6.TryParse(f.prefix)
+	if err != nil {
+		// invalid prefix is reported when generating addresses
+		return 128
+	}
+	return 128 - p.Prefix().Bits()
+}
+
+// random returns a random IPv6 address with the constraints in f
+func (f *ipv6Flags) random(gen *ipv6.Generator) (*ipv6.IPv6, error) {
+	ip, err := gen.Random()
+	if err != nil {
+		return nil, err
+	}
+	if f.prefix != "" {
+		if err := ip.TrySetPrefix(f.prefix); err != nil {
+			return nil, usageErrorf("-prefix: %w", err)
+		}
+	}
+	return ip, nil
+}
+
+// runIPv6 runs the ipv6 subcommand
+func runIPv6(args []string) error {
+	fs := newFlagSet("ipv6")
+	f := &ipv6Flags{}
+	f.addFlags(fs)
+	g := &genFlags{}
+	g.addFlags(fs)
+	o := &formatFlags{}
+	o.addFlags(fs)
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	if err := o.check(); err != nil {
+		return err
+	}
+	if err := g.check(spaceSize(f.freeBits())); err != nil {
+		return err
+	}
+
+	r, err := g.reader("ipv6")
+	if err != nil {
+		return err
+	}
+	gen := ipv6.NewGenerator(r)
+	ips, err := generate(g, func() (*ipv6.IPv6, error) {
+		return f.random(gen)
+	})
+	if err != nil {
+		return err
+	}
+	return printAddrs(o, "Random IPv6 Address", ips)
+}
@@ -0,0 +1,655 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hwipl/random-addr/mac"
+)
+
+// macFlags are the command line flags of the mac subcommand
+type macFlags struct {
+	local     bool
+	universal bool
+	unicast   bool
+	multicast bool
+	oui       string
+	nic       string
+	prefix    string
+	preset    string
+	vendor    string
+	list      bool
+	slap      string
+	cid       string
+	notation  string
+	forms     string
+	wellKnown string
+	listWK    bool
+	avoidWK   bool
+
+	// quadrant is the SLAP quadrant parsed from slap
+	quadrant mac.Quadrant
+
+	// cidBytes is the Company ID parsed from cid
+	cidBytes [3]byte
+
+	// prefixValue is the prefix parsed from prefix
+	prefixValue *mac.Prefix
+
+	// notationValue is the notation parsed from notation
+	notationValue mac.Notation
+
+	// formValues are the forms parsed from forms
+	formValues []mac.Form
+
+	// assignments are the registry assignments matching vendor
+	assignments []*mac.Assignment
+
+	// wellKnownRanges are the well-known ranges matching wellKnown
+	wellKnownRanges []*mac.WellKnown
+}
+
+// maxAvoidAttempts is the maximum number of attempts to generate an address
+// outside of the well-known ranges
+const maxAvoidAttempts = 1000
+
+// addFlags adds the mac flags to fs
+func (f *macFlags) addFlags(fs *flag.FlagSet) {
+	fs.BoolVar(&f.local, "local", false,
+		"generate a locally administered address (U/L bit 1)")
+	fs.BoolVar(&f.universal, "universal", false,
+		"generate a universally administered address (U/L bit 0)")
+	fs.BoolVar(&f.unicast, "unicast", false,
+		"generate an individual (unicast) address (I/G bit 0)")
+	fs.BoolVar(&f.multicast, "multicast", false,
+		"generate a group (multicast) address (I/G bit 1)")
+	fs.StringVar(&f.oui, "oui", "",
+		"set the OUI part of the address, e.g., 52:54:00")
+	fs.StringVar(&f.nic, "nic", "",
+		"set the NIC specific part of the address, e.g., 12:34:56")
+	fs.StringVar(&f.prefix, "prefix", "",
+		"generate the address inside `prefix` of any length, "+
+			"e.g., 02:12:34:50:00:00/28")
+	fs.StringVar(&f.preset, "preset", "",
+		"generate the address in the range of hypervisor or container "+
+			"`platform`: "+strings.Join(mac.PresetNames(), ", "))
+	fs.StringVar(&f.vendor, "vendor", "",
+		"generate the address in a block assigned to a vendor matching "+
+			"`name` or case-insensitive regular expression, e.g., intel")
+	fs.BoolVar(&f.list, "list-vendors", false,
+		"list the registry assignments matching -vendor and exit")
+	fs.StringVar(&f.slap, "slap", "",
+		"generate a local address in IEEE 802c SLAP `quadrant`: "+
+			"aai, eli, sai or reserved")
+	fs.StringVar(&f.cid, "cid", "",
+		"set the Company ID of an ELI address, e.g., 0a:11:22, "+
+			"implies -slap eli")
+	fs.StringVar(&f.notation, "notation", "colon",
+		"print the address in `notation`: "+
+			strings.Join(mac.Notations(), ", ")+
+			", prefix with upper- for upper case, e.g., upper-hyphen")
+	addFormsFlag(fs, &f.forms)
+	fs.StringVar(&f.wellKnown, "well-known", "",
+		"generate the address in a well-known range matching `name` or "+
+			"case-insensitive regular expression, e.g., lldp")
+	fs.BoolVar(&f.listWK, "list-well-known", false,
+		"list the well-known ranges matching -well-known and exit")
+	fs.BoolVar(&f.avoidWK, "avoid-well-known", false,
+		"do not generate addresses in well-known ranges, e.g., broadcast")
+}
+
+// prepareWellKnown checks the well-known flags in f and resolves the
+// well-known ranges
+func (f *macFlags) prepareWellKnown() error {
+	if f.wellKnown == "" && !f.listWK {
+		return nil
+	}
+	switch {
+	case f.avoidWK:
+		return usageErrorf("-well-known and -avoid-well-known are " +
+			"exclusive")
+	case f.oui != "":
+		return usageErrorf("-well-known and -oui are exclusive")
+	case f.vendor != "" || f.list:
+		return usageErrorf("-well-known and -vendor are exclusive")
+	case f.slap != "":
+		return usageErrorf("-well-known and -slap are exclusive")
+	}
+
+	found, err := mac.FindWellKnown(f.wellKnown)
+	if err != nil {
+		return usageErrorf("-well-known: %w", err)
+	}
+	if len(found) == 0 {
+		return fmt.Errorf("no well-known range matches %q", f.wellKnown)
+	}
+	f.wellKnownRanges = found
+	return nil
+}
+
+// preparePrefix checks the prefix and preset flags in f and parses the
+// prefix, a preset is used as prefix
+func (f *macFlags) preparePrefix() error {
+	name := "-prefix"
+	switch {
+	case f.prefix != "" && f.preset != "":
+		return usageErrorf("-prefix and -preset are exclusive")
+	case f.preset != "":
+		name = "-preset"
+	case f.prefix == "":
+		return nil
+	}
+	switch {
+	case f.oui != "":
+		return usageErrorf("%s and -oui are exclusive", name)
+	case f.vendor != "":
+		return usageErrorf("%s and -vendor are exclusive", name)
+	case f.slap != "" || f.cid != "":
+		return usageErrorf("%s and -slap are exclusive", name)
+	case f.wellKnown != "":
+		return usageErrorf("%s and -well-known are exclusive", name)
+	}
+
+	if f.preset != "" {
+		p, err := mac.LookupPreset(f.preset)
+		if err != nil {
+			return usageErrorf("-preset: %w", err)
+		}
+		f.prefixValue = p.Prefix
+		return nil
+	}
+	p, err := mac.TryParsePrefix(f.prefix)
+	if err != nil {
+		return usageErrorf("-prefix: %w", err)
+	}
+	f.prefixValue = p
+	return nil
+}
+
+// prepareSLAP checks the SLAP flags in f and parses quadrant and CID
+func (f *macFlags) prepareSLAP() error {
+	if f.cid != "" {
+		if f.slap == "" {
+			f.slap = "eli"
+		}
+		cid, err := mac.ParseOUI(f.cid)
+		if err != nil {
+			return usageErrorf("-cid: %w", err)
+		}
+		f.cidBytes = cid
+	}
+	if f.slap == "" {
+		return nil
+	}
+
+	q, err := mac.ParseQuadrant(f.slap)
+	if err != nil {
+		return usageErrorf("-slap: %w", err)
+	}
+	f.quadrant = q
+	switch {
+	case f.universal:
+		return usageErrorf("-slap and -universal are exclusive")
+	case f.oui != "":
+		return usageErrorf("-slap and -oui are exclusive")
+	case f.vendor != "":
+		return usageErrorf("-slap and -vendor are exclusive")
+	case q == mac.QuadrantELI && f.cid == "":
+		return usageErrorf("-slap eli requires -cid")
+	case q != mac.QuadrantELI && f.cid != "":
+		return usageErrorf("-cid requires -slap eli")
+	}
+	return nil
+}
+
+// prepare checks the flags in f and resolves the vendor, it must be called
+// before generating addresses
+func (f *macFlags) prepare() error {
+	if f.local && f.universal {
+		return usageErrorf("-local and -universal are exclusive")
+	}
+	if f.unicast && f.multicast {
+		return usageErrorf("-unicast and -multicast are exclusive")
+	}
+	n, err := mac.ParseNotation(f.notation)
+	if err != nil {
+		return usageErrorf("-notation: %w", err)
+	}
+	f.notationValue = n
+	if f.formValues, err = parseForms(f.forms); err != nil {
+		return err
+	}
+	if err := f.preparePrefix(); err != nil {
+		return err
+	}
+	if err := f.prepareSLAP(); err != nil {
+		return err
+	}
+	if err := f.prepareWellKnown(); err != nil {
+		return err
+	}
+	if f.vendor == "" && !f.list {
+		return nil
+	}
+	if f.oui != "" {
+		return usageErrorf("-oui and -vendor are exclusive")
+	}
+
+	found, err := mac.DefaultRegistry().FindVendor(f.vendor)
+	if err != nil {
+		return usageErrorf("-vendor: %w", err)
+	}
+	if len(found) == 0 {
+		return fmt.Errorf("no vendor matches %q", f.vendor)
+	}
+	f.assignments = found
+	return nil
+}
+
+// printVendors prints the assignments matching the vendor in f
+func (f *macFlags) printVendors() {
+	for _, a := range f.assignments {
+		fmt.Printf("%-20s %-5s %s\n", a.Block(), a.Registry,
+			a.Organization)
+	}
+}
+
+// printWellKnown prints the well-known ranges matching the name in f
+func (f *macFlags) printWellKnown() {
+	for _, w := range f.wellKnownRanges {
+		fmt.Printf("%-20s %s\n", w.Block(), w.Description())
+	}
+}
+
+// size returns the number of addresses allowed by the constraints in f,
+// with vendor assignments or well-known ranges it is the sum of their sizes
+func (f *macFlags) size() uint64 {
+	var blocks []int
+	for _, a := range f.assignments {
+		blocks = append(blocks, a.Bits)
+	}
+	if len(blocks) == 0 {
+		for _, w := range f.wellKnownRanges {
+			blocks = append(blocks, w.Bits)
+		}
+	}
+	if len(blocks) == 0 {
+		return spaceSize(f.freeBits())
+	}
+
+	var n uint64
+	for _, bits := range blocks {
+		free := 48 - bits
+		if f.nic != "" {
+			// the NIC specific part fixes the last 24 bits
+			free = max(0, 24-bits)
+		}
+		n += 1 << free
+	}
+	return n
+}
+
+// freeBits returns the number of random bits left by the constraints in f
+// without vendor assignments or well-known ranges
+func (f *macFlags) freeBits() int {
+	free := 48
+	if f.prefixValue != nil {
+		free -= f.prefixValue.Bits()
+	} else if f.oui != "" || f.quadrant == mac.QuadrantELI {
+		free -= 24
+	} else if f.quadrant != mac.QuadrantNone {
+		// U/L, Y, Z and I/G bits
+		free -= 4
+	} else {
+		if f.local || f.universal {
+			free--
+		}
+		if f.unicast || f.multicast {
+			free--
+		}
+	}
+	if f.nic != "" {
+		free -= min(24, free)
+	}
+	return free
+}
+
+// space returns the prefix of the addresses allowed by the constraints in
+// f or nil if they are not a single prefix
+func (f *macFlags) space() *mac.Prefix {
+	var p *mac.Prefix
+	switch {
+	case len(f.assignments) > 1 || len(f.wellKnownRanges) > 1:
+		return nil
+	case len(f.assignments) == 1:
+		p = f.assignments[0].Range()
+	case len(f.wellKnownRanges) == 1:
+		p = f.wellKnownRanges[0].Range()
+	case f.prefixValue != nil:
+		p = f.prefixValue
+	case f.oui != "" || f.quadrant == mac.QuadrantELI:
+		oui := f.cidBytes
+		if f.oui != "" {
+			var err error
+			if oui, err = mac.ParseOUI(f.oui); err != nil {
+				return nil
+			}
+		}
+		m := mac.FromUint64(0)
+		m.SetOUI(oui)
+		p, _ = mac.NewPrefix(m, 24)
+	default:
+		// the other constraints only fix bits of the first byte
+		return nil
+	}
+	if f.nic == "" {
+		return p
+	}
+
+	// the NIC specific part only leaves a prefix if it fixes all bits
+	// after the prefix
+	nic, err := mac.ParseNIC(f.nic)
+	if err != nil || p.Bits() < 24 {
+		return nil
+	}
+	m := p.Addr()
+	m.SetNIC(nic)
+	if !p.Contains(m) {
+		return nil
+	}
+	p, _ = mac.NewPrefix(m, 48)
+	return p
+}
+
+// excluded returns the number of addresses inside the address space of
+// the constraints in f that are not generated because they are avoided
+// well-known addresses or in the known addresses
+func (f *macFlags) excluded(known *mac.Set) uint64 {
+	p := f.space()
+	if p == nil {
+		return 0
+	}
+	var n uint64
+	if f.avoidWK {
+		n = p.WellKnownSize()
+	}
+	if known == nil {
+		return n
+	}
+	for m := range known.All() {
+		if !p.Contains(m) {
+			continue
+		}
+		if f.avoidWK && mac.LookupWellKnown(m) != nil {
+			// already counted above
+			continue
+		}
+		n++
+	}
+	return n
+}
+
+// inAssignments returns whether m is inside one of the vendor assignments
+// or well-known ranges
+func (f *macFlags) inAssignments(m *mac.MAC) bool {
+	for _, a := range f.assignments {
+		if a.Contains(m) {
+			return true
+		}
+	}
+	for _, w := range f.wellKnownRanges {
+		if w.Contains(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// random returns a random MAC address with the constraints in f, it
+// regenerates addresses in well-known ranges if requested in f
+func (f *macFlags) random(gen *mac.Generator) (*mac.MAC, error) {
+	for i := 0; ; i++ {
+		m, err := f.randomCandidate(gen)
+		if err != nil {
+			return nil, err
+		}
+		if !f.avoidWK || mac.LookupWellKnown(m) == nil {
+			return m, nil
+		}
+		if i >= maxAvoidAttempts {
+			return nil, fmt.Errorf("%w: constraints only allow "+
+				"well-known addresses", errAddressSpace)
+		}
+	}
+}
+
+// randomCandidate returns a random MAC address with the constraints in f
+func (f *macFlags) randomCandidate(gen *mac.Generator) (*mac.MAC, error) {
+	// pick generator
+	var m *mac.MAC
+	var err error
+	switch {
+	case len(f.assignments) > 0:
+		m, err = gen.RandomAssignment(f.assignments)
+	case len(f.wellKnownRanges) > 0:
+		m, err = gen.RandomWellKnown(f.wellKnownRanges)
+	case f.quadrant == mac.QuadrantELI:
+		m, err = gen.RandomELI(f.cidBytes)
+		if err != nil {
+			return nil, usageErrorf("-cid: %w", err)
+		}
+	case f.quadrant != mac.QuadrantNone:
+		m, err = gen.RandomQuadrant(f.quadrant)
+	case f.universal && f.unicast:
+		m, err = gen.RandomUI()
+	case f.universal && f.multicast:
+		m, err = gen.RandomUG()
+	case f.local && f.unicast:
+		m, err = gen.RandomLI()
+	case f.local && f.multicast:
+		m, err = gen.RandomLG()
+	default:
+		m, err = gen.Random()
+		if err != nil {
+			return nil, err
+		}
+		if f.local || f.universal {
+			m.SetUL(f.universal)
+		}
+		if f.unicast || f.multicast {
+			m.SetIG(f.unicast)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if f.quadrant != mac.QuadrantNone && f.multicast {
+		m.SetMulticast()
+	}
+
+	// pin fixed bytes
+	if f.oui != "" {
+		oui, err := mac.ParseOUI(f.oui)
+		if err != nil {
+			return nil, usageErrorf("-oui: %w", err)
+		}
+		m.SetOUI(oui)
+	}
+	if f.prefixValue != nil {
+		m.SetPrefix(f.prefixValue)
+	}
+	if f.oui != "" || f.prefixValue != nil || len(f.assignments) > 0 ||
+		len(f.wellKnownRanges) > 0 {
+		fixed := m.OUI()
+		if f.prefixValue != nil {
+			fixed = f.prefixValue.String()
+		}
+		if (f.local && m.Universal()) || (f.universal && m.Local()) {
+			return nil, usageErrorf("%s conflicts with U/L flag",
+				fixed)
+		}
+		if (f.unicast && m.Multicast()) || (f.multicast && m.Unicast()) {
+			return nil, usageErrorf("%s conflicts with I/G flag",
+				fixed)
+		}
+	}
+	if f.nic != "" {
+		nic, err := mac.ParseNIC(f.nic)
+		if err != nil {
+			return nil, usageErrorf("-nic: %w", err)
+		}
+		m.SetNIC(nic)
+		if (len(f.assignments) > 0 || len(f.wellKnownRanges) > 0) &&
+			!f.inAssignments(m) {
+			return nil, usageErrorf("-nic %s conflicts with -vendor "+
+				"or -well-known block", f.nic)
+		}
+		if f.prefixValue != nil && !f.prefixValue.Contains(m) {
+			return nil, usageErrorf("-nic %s conflicts with -prefix",
+				f.nic)
+		}
+	}
+
+	return m, nil
+}
+
+// addFormsFlag adds the flag for additional forms of MAC addresses to fs
+func addFormsFlag(fs *flag.FlagSet, forms *string) {
+	fs.StringVar(forms, "forms", "",
+		"show MAC addresses also in comma-separated `forms`, "+
+			"structured output formats get one field per form: "+
+			strings.Join(mac.Forms(), ", "))
+}
+
+// parseForms parses the comma-separated form names in s
+func parseForms(s string) ([]mac.Form, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var forms []mac.Form
+	for _, name := range strings.Split(s, ",") {
+		f, err := mac.LookupForm(strings.TrimSpace(name))
+		if err != nil {
+			return nil, usageErrorf("-forms: %w", err)
+		}
+		forms = append(forms, f)
+	}
+	return forms, nil
+}
+
+// notatedMAC is a MAC address that is printed in a notation and with
+// additional forms
+type notatedMAC struct {
+	*mac.MAC
+	notation mac.Notation
+	forms    []mac.Form
+}
+
+// String returns m as string in its notation
+func (m notatedMAC) String() string {
+	return m.Format(m.notation)
+}
+
+// record returns m as record with the address in its notation and one
+// field per additional form appended, e.g., "hex_int"
+func (m notatedMAC) record() record {
+	r := macRecord(m.MAC)
+	// address is the last field of MAC records
+	r[len(r)-1].value = m.String()
+	for _, f := range m.forms {
+		name := strings.ReplaceAll(f.String(), "-", "_")
+		r = append(r, field{name, m.FormatForm(f)})
+	}
+	return r
+}
+
+// All returns all information about m including its additional forms
+func (m notatedMAC) All() string {
+	return m.AllWith(m.forms...)
+}
+
+// Table returns all information about m including its additional forms as
+// table
+func (m notatedMAC) Table() string {
+	return m.TableWith(m.forms...)
+}
+
+// runMAC runs the mac subcommand
+func runMAC(args []string) error {
+	fs := newFlagSet("mac")
+	f := &macFlags{}
+	f.addFlags(fs)
+	g := &genFlags{}
+	g.addFlags(fs)
+	o := &formatFlags{}
+	o.addFlags(fs)
+	rf := &registryFlags{}
+	rf.addFlags(fs)
+	a := &applyFlags{}
+	a.addFlags(fs)
+	av := &avoidFlags{}
+	av.addFlags(fs)
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	if err := o.check(); err != nil {
+		return err
+	}
+	if err := a.check(fs, g, o); err != nil {
+		return err
+	}
+	if err := rf.load(); err != nil {
+		return err
+	}
+	if err := f.prepare(); err != nil {
+		return err
+	}
+	if f.list {
+		f.printVendors()
+		return nil
+	}
+	if f.listWK {
+		f.printWellKnown()
+		return nil
+	}
+	if err := g.check(f.size()); err != nil {
+		return err
+	}
+	if a.restore {
+		return a.restorePermanent(f.notationValue)
+	}
+
+	if err := av.load(); err != nil {
+		return err
+	}
+	if err := g.checkSpace(f.size(), f.excluded(av.known)); err != nil {
+		return err
+	}
+
+	r, err := g.reader("mac")
+	if err != nil {
+		return err
+	}
+	gen := mac.NewGenerator(r)
+	macs, err := generate(g, func() (*mac.MAC, error) {
+		return av.random(func() (*mac.MAC, error) {
+			return f.random(gen)
+		})
+	})
+	if err != nil {
+		return err
+	}
+	av.report()
+	if a.iface != "" {
+		return a.apply(macs[0], f.notationValue)
+	}
+	if f.notationValue == mac.NotationColon && len(f.formValues) == 0 {
+		return printAddrs(o, "Random MAC Address", macs)
+	}
+	notated := make([]notatedMAC, len(macs))
+	for i, m := range macs {
+		notated[i] = notatedMAC{m, f.notationValue, f.formValues}
+	}
+	return printAddrs(o, "Random MAC Address", notated)
+}
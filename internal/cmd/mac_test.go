@@ -0,0 +1,226 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hwipl/random-addr/mac"
+)
+
+// TestRunMACVendor tests generating addresses in MA-M (28 bit) and MA-S
+// (36 bit) vendor blocks of a registry file
+func TestRunMACVendor(t *testing.T) {
+	// -oui-file replaces the default registry
+	t.Cleanup(func() { mac.SetDefaultRegistry(nil) })
+
+	for _, test := range []struct {
+		vendor string
+		block  string
+	}{
+		{"Test MA-M", "8c:1f:64:10:00:00/28"},
+		{"Test MA-S", "70:b3:d5:12:30:00/36"},
+	} {
+		code, stdout, stderr := captureRun(t, "mac", "-oui-file",
+			"testdata/registry.csv", "-vendor", test.vendor,
+			"-list-vendors")
+		if code != 0 {
+			t.Fatalf("%s: got exit code %d: %s", test.vendor, code,
+				stderr)
+		}
+		if !strings.HasPrefix(stdout, test.block+" ") {
+			t.Errorf("%s: got %q, want block %s", test.vendor, stdout,
+				test.block)
+		}
+
+		code, stdout, stderr = captureRun(t, "mac", "-oui-file",
+			"testdata/registry.csv", "-vendor", test.vendor,
+			"-seed", "1", "-n", "50", "-unique")
+		if code != 0 {
+			t.Fatalf("%s: got exit code %d: %s", test.vendor, code,
+				stderr)
+		}
+		prefix := mac.ParsePrefix(test.block)
+		lines := strings.Fields(stdout)
+		if len(lines) != 50 {
+			t.Errorf("%s: got %d addresses, want 50", test.vendor,
+				len(lines))
+		}
+		for _, s := range lines {
+			m := mac.Parse(s)
+			if !prefix.Contains(m) {
+				t.Errorf("%s: %s not in %s", test.vendor, m, prefix)
+			}
+			if v := m.Vendor(); !strings.HasPrefix(v, test.vendor) {
+				t.Errorf("%s: got vendor %q", test.vendor, v)
+			}
+		}
+	}
+}
+
+// TestRunMACAvoidWellKnown tests the address space of unique addresses
+// outside of the well-known ranges
+func TestRunMACAvoidWellKnown(t *testing.T) {
+	for _, test := range []struct {
+		prefix string
+		n      int
+		code   int
+		stderr string
+	}{
+		{"ff:ff:ff:ff:ff:f0/44", 15, 0, ""},
+		{"ff:ff:ff:ff:ff:f0/44", 16, exitError, "allow only 15"},
+		{"01:80:c2:00:00:00/40", 208, 0, ""},
+		{"01:80:c2:00:00:00/40", 209, exitError, "allow only 208"},
+	} {
+		code, stdout, stderr := captureRun(t, "mac", "-prefix",
+			test.prefix, "-avoid-well-known", "-seed", "1", "-n",
+			strconv.Itoa(test.n), "-unique")
+		if code != test.code || !strings.Contains(stderr, test.stderr) {
+			t.Errorf("%s -n %d: got exit code %d %q, want %d %q",
+				test.prefix, test.n, code, stderr, test.code,
+				test.stderr)
+		}
+		if code != 0 {
+			continue
+		}
+		lines := strings.Fields(stdout)
+		if len(lines) != test.n {
+			t.Errorf("%s: got %d addresses, want %d", test.prefix,
+				len(lines), test.n)
+		}
+		for _, s := range lines {
+			if w := mac.LookupWellKnown(mac.Parse(s)); w != nil {
+				t.Errorf("%s: got well-known address %s", test.prefix,
+					s)
+			}
+		}
+	}
+}
+
+// TestRunMACAvoidFile tests the address space of unique addresses that are
+// not in the known addresses
+func TestRunMACAvoidFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "known")
+	known := "52:54:00:00:00:01\n00:11:22:33:44:55\n"
+	if err := os.WriteFile(file, []byte(known), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		n      string
+		code   int
+		stderr string
+	}{
+		{"3", 0, "rejected: "},
+		{"4", exitError, "allow only 3"},
+	} {
+		code, stdout, stderr := captureRun(t, "mac", "-prefix",
+			"52:54:00:00:00:00/46", "-avoid-file", file, "-seed", "1",
+			"-n", test.n, "-unique")
+		if code != test.code || !strings.Contains(stderr, test.stderr) {
+			t.Errorf("-n %s: got exit code %d %q, want %d %q", test.n,
+				code, stderr, test.code, test.stderr)
+		}
+		if strings.Contains(stdout, "52:54:00:00:00:01") {
+			t.Errorf("-n %s: got known address in %q", test.n, stdout)
+		}
+	}
+}
+
+// TestRunMACWellKnownSpace tests the address space of unique addresses in
+// several well-known ranges of different sizes
+func TestRunMACWellKnownSpace(t *testing.T) {
+	// the Cisco ranges are a /40, a /36 and two /48 blocks
+	for _, test := range []struct {
+		n      int
+		code   int
+		stderr string
+	}{
+		{4354, 0, ""},
+		{4355, exitError, "allow only 4354"},
+	} {
+		code, stdout, stderr := captureRun(t, "mac", "-well-known",
+			"Cisco", "-seed", "1", "-n", strconv.Itoa(test.n),
+			"-unique")
+		if code != test.code || !strings.Contains(stderr, test.stderr) {
+			t.Errorf("-n %d: got exit code %d %q, want %d %q", test.n,
+				code, stderr, test.code, test.stderr)
+		}
+		if code == 0 && strings.Count(stdout, "\n") != test.n {
+			t.Errorf("-n %d: got %d addresses", test.n,
+				strings.Count(stdout, "\n"))
+		}
+	}
+}
+
+// TestRunMACRecordSchema tests that the fields of MAC records do not depend
+// on the notation
+func TestRunMACRecordSchema(t *testing.T) {
+	var header string
+	for _, test := range []struct {
+		notation string
+		address  string
+	}{
+		{"colon", "52:54:00:12:34:56"},
+		{"upper-hyphen", "52-54-00-12-34-56"},
+		{"cisco", "5254.0012.3456"},
+	} {
+		code, stdout, stderr := captureRun(t, "mac", "-prefix",
+			"52:54:00:12:34:56/48", "-notation", test.notation,
+			"-format", "csv")
+		if code != 0 {
+			t.Fatalf("%s: got exit code %d: %s", test.notation, code,
+				stderr)
+		}
+		lines := strings.Split(strings.TrimSpace(stdout), "\n")
+		if len(lines) != 2 {
+			t.Fatalf("%s: got %q, want header and record",
+				test.notation, stdout)
+		}
+		if header == "" {
+			header = lines[0]
+		}
+		if lines[0] != header {
+			t.Errorf("%s: got header %q, want %q", test.notation,
+				lines[0], header)
+		}
+		if !strings.HasPrefix(lines[1], "1,mac,52:54:00:12:34:56,") ||
+			!strings.HasSuffix(lines[1], ","+test.address) {
+			t.Errorf("%s: got record %q, want address %s",
+				test.notation, lines[1], test.address)
+		}
+	}
+}
+
+// TestRunMACFormsRecords tests the fields of additional forms in structured
+// output of the mac and explain subcommands
+func TestRunMACFormsRecords(t *testing.T) {
+	want := []string{
+		`"decimal":"73588229205"`,
+		`"hex_int":"0x001122334455"`,
+		`"octal":"0o1044214642125"`,
+		`"base64":"ABEiM0RV"`,
+		`"byte_array":"{0x00, 0x11, 0x22, 0x33, 0x44, 0x55}"`,
+		`"eui48":"00-11-22-33-44-55"`,
+	}
+	forms := "decimal,hex-int,octal,base64,byte-array,eui48"
+	for _, args := range [][]string{
+		{"mac", "-prefix", "00:11:22:33:44:55/48", "-forms", forms,
+			"-format", "jsonl"},
+		{"explain", "-forms", forms, "-format", "jsonl",
+			"00:11:22:33:44:55"},
+		{"explain", "-parse-as", "decimal", "-forms", forms, "-format",
+			"jsonl", "73588229205"},
+	} {
+		code, stdout, stderr := captureRun(t, args...)
+		if code != 0 {
+			t.Fatalf("%q: got exit code %d: %s", args, code, stderr)
+		}
+		for _, w := range want {
+			if !strings.Contains(stdout, w) {
+				t.Errorf("%q: got %s, want %s", args, stdout, w)
+			}
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"flag"
+
+	"github.com/hwipl/random-addr/mac"
+)
+
+// registryFlags are the command line flags for the MAC vendor registry
+type registryFlags struct {
+	files []string
+}
+
+// addFlags adds the registry flags to fs
+func (f *registryFlags) addFlags(fs *flag.FlagSet) {
+	fs.Func("oui-file", "load IEEE registry CSV `file` (oui.csv, mam.csv "+
+		"or oui36.csv) in addition to the embedded registry "+
+		"(snapshot "+mac.EmbeddedRegistryDate()+"), "+
+		"can be specified multiple times",
+		func(s string) error {
+			f.files = append(f.files, s)
+			return nil
+		})
+}
+
+// load loads the registry files in f and makes the result the default
+// registry
+func (f *registryFlags) load() error {
+	if len(f.files) == 0 {
+		return nil
+	}
+	r, err := mac.NewEmbeddedRegistry()
+	if err != nil {
+		return err
+	}
+	for _, file := range f.files {
+		if err := r.LoadFile(file); err != nil {
+			return err
+		}
+	}
+	mac.SetDefaultRegistry(r)
+	return nil
+}
@@ -0,0 +1,213 @@
+// Package hostinfo reads the addresses of the local network interfaces and
+// neighbors from the Linux /sys and /proc file systems
+package hostinfo
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// sysNet is the directory with the network interfaces in sysfs
+	sysNet = "/sys/class/net"
+
+	// procNet is the network directory in procfs
+	procNet = "/proc/net"
+
+	// ifaTemporary is the flag of temporary IPv6 addresses in if_inet6
+	ifaTemporary = 0x01
+)
+
+// ErrNotSupported is returned if reading the neighbor cache is not supported
+// on the operating system
+var ErrNotSupported = errors.New("not supported on this operating system")
+
+// IPv6Addr is an IPv6 address of an interface
+type IPv6Addr struct {
+	// Prefix is the address with its prefix length
+	Prefix netip.Prefix
+
+	// Temporary is set if the address is a temporary (privacy) address
+	Temporary bool
+}
+
+// Interface is a network interface and its addresses
+type Interface struct {
+	// Name is the name of the interface
+	Name string
+
+	// MAC is the hardware address of the interface, it is empty if the
+	// interface has no hardware address
+	MAC string
+
+	// IPv4 contains the IPv4 addresses of the interface
+	IPv4 []netip.Prefix
+
+	// IPv6 contains the IPv6 addresses of the interface
+	IPv6 []*IPv6Addr
+}
+
+// Neighbor is an entry in a neighbor cache, e.g., the ARP cache
+type Neighbor struct {
+	// IP is the IP address of the neighbor
+	IP netip.Addr
+
+	// MAC is the hardware address of the neighbor
+	MAC string
+
+	// Device is the name of the interface the neighbor is reachable on
+	Device string
+}
+
+// parseIfInet6 parses the IPv6 addresses in if_inet6 format in r, it
+// returns the addresses per interface name
+func parseIfInet6(r io.Reader) (map[string][]*IPv6Addr, error) {
+	addrs := make(map[string][]*IPv6Addr)
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		f := strings.Fields(s.Text())
+		if len(f) < 6 || len(f[0]) != 32 {
+			return nil, fmt.Errorf("if_inet6: invalid line %q", s.Text())
+		}
+		var b [16]byte
+		for i := range b {
+			v, err := strconv.ParseUint(f[0][2*i:2*i+2], 16, 8)
+			if err != nil {
+				return nil, fmt.Errorf("if_inet6: invalid address %q",
+					f[0])
+			}
+			b[i] = byte(v)
+		}
+		bits, err := strconv.ParseUint(f[2], 16, 8)
+		if err != nil {
+			return nil, fmt.Errorf("if_inet6: invalid prefix %q", f[2])
+		}
+		flags, err := strconv.ParseUint(f[4], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("if_inet6: invalid flags %q", f[4])
+		}
+		addrs[f[5]] = append(addrs[f[5]], &IPv6Addr{
+			Prefix:    netip.PrefixFrom(netip.AddrFrom16(b), int(bits)),
+			Temporary: flags&ifaTemporary != 0,
+		})
+	}
+	return addrs, s.Err()
+}
+
+// parseARP parses the ARP cache in /proc/net/arp format in r, incomplete
+// entries are skipped
+func parseARP(r io.Reader) ([]*Neighbor, error) {
+	var neighbors []*Neighbor
+	s := bufio.NewScanner(r)
+	for i := 0; s.Scan(); i++ {
+		if i == 0 {
+			// skip header
+			continue
+		}
+		f := strings.Fields(s.Text())
+		if len(f) < 6 {
+			return nil, fmt.Errorf("arp: invalid line %q", s.Text())
+		}
+		ip, err := netip.ParseAddr(f[0])
+		if err != nil {
+			return nil, fmt.Errorf("arp: %w", err)
+		}
+		// flags 0x0 is an incomplete entry
+		if f[2] == "0x0" || f[3] == "00:00:00:00:00:00" {
+			continue
+		}
+		neighbors = append(neighbors, &Neighbor{
+			IP:     ip,
+			MAC:    f[3],
+			Device: f[5],
+		})
+	}
+	return neighbors, s.Err()
+}
+
+// readIfInet6 reads the IPv6 addresses of all interfaces, it returns no
+// addresses if IPv6 is disabled
+func readIfInet6() (map[string][]*IPv6Addr, error) {
+	f, err := os.Open(filepath.Join(procNet, "if_inet6"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseIfInet6(f)
+}
+
+// interfaceIPv4 returns the IPv4 addresses of the interface with name
+func interfaceIPv4(name string) ([]netip.Prefix, error) {
+	ifi, err := net.InterfaceByName(name)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := ifi.Addrs()
+	if err != nil {
+		return nil, err
+	}
+	var prefixes []netip.Prefix
+	for _, a := range addrs {
+		ipnet, ok := a.(*net.IPNet)
+		if !ok || ipnet.IP.To4() == nil {
+			continue
+		}
+		ip, _ := netip.AddrFromSlice(ipnet.IP.To4())
+		bits, _ := ipnet.Mask.Size()
+		prefixes = append(prefixes, netip.PrefixFrom(ip, bits))
+	}
+	return prefixes, nil
+}
+
+// Interfaces returns all network interfaces sorted by name
+func Interfaces() ([]*Interface, error) {
+	entries, err := os.ReadDir(sysNet)
+	if err != nil {
+		return nil, err
+	}
+	ipv6, err := readIfInet6()
+	if err != nil {
+		return nil, err
+	}
+
+	var ifaces []*Interface
+	for _, e := range entries {
+		iface := &Interface{Name: e.Name(), IPv6: ipv6[e.Name()]}
+		a, err := os.ReadFile(filepath.Join(sysNet, e.Name(), "address"))
+		if err == nil {
+			iface.MAC = strings.TrimSpace(string(a))
+		}
+		// the interface may be gone or its addresses unreadable,
+		// keep it without IPv4 addresses like without MAC address
+		if ip, err := interfaceIPv4(e.Name()); err == nil {
+			iface.IPv4 = ip
+		}
+		ifaces = append(ifaces, iface)
+	}
+	sort.Slice(ifaces, func(i, j int) bool {
+		return ifaces[i].Name < ifaces[j].Name
+	})
+	return ifaces, nil
+}
+
+// ARP returns the entries of the ARP cache
+func ARP() ([]*Neighbor, error) {
+	f, err := os.Open(filepath.Join(procNet, "arp"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseARP(f)
+}
@@ -0,0 +1,56 @@
+package hostinfo
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestParseIfInet6 tests parseIfInet6
+func TestParseIfInet6(t *testing.T) {
+	in := `fe8000000000000000fc00fffe000001 04 40 20 80     eth0
+00000000000000000000000000000001 01 80 10 80       lo
+20010db8000000001234567890abcdef 04 40 00 01     eth0
+`
+	addrs, err := parseIfInet6(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(addrs["eth0"]) != 2 || len(addrs["lo"]) != 1 {
+		t.Fatalf("got %v, want 2 eth0 and 1 lo addresses", addrs)
+	}
+	for i, want := range []string{
+		"fe80::fc:ff:fe00:1/64",
+		"2001:db8::1234:5678:90ab:cdef/64",
+	} {
+		if got := addrs["eth0"][i].Prefix.String(); got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+	if addrs["eth0"][0].Temporary || !addrs["eth0"][1].Temporary {
+		t.Errorf("wrong temporary flags")
+	}
+
+	if _, err := parseIfInet6(strings.NewReader("fe80 eth0\n")); err == nil {
+		t.Errorf("got nil, want error")
+	}
+}
+
+// TestParseARP tests parseARP
+func TestParseARP(t *testing.T) {
+	in := `IP address       HW type     Flags       HW address            Mask     Device
+192.0.2.1        0x1         0x2         02:fc:00:00:00:05     *        eth0
+192.0.2.2        0x1         0x0         00:00:00:00:00:00     *        eth0
+`
+	neighbors, err := parseARP(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(neighbors) != 1 {
+		t.Fatalf("got %d neighbors, want 1", len(neighbors))
+	}
+	n := neighbors[0]
+	if n.IP.String() != "192.0.2.1" || n.MAC != "02:fc:00:00:00:05" ||
+		n.Device != "eth0" {
+		t.Errorf("got %v, want 192.0.2.1 02:fc:00:00:00:05 eth0", n)
+	}
+}
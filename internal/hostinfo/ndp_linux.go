@@ -0,0 +1,91 @@
+package hostinfo
+
+import (
+	"encoding/binary"
+	"net"
+	"net/netip"
+	"os"
+	"syscall"
+)
+
+const (
+	// sizeofNdMsg is the size of the ndmsg struct, it is missing in the
+	// syscall package
+	sizeofNdMsg = 12
+
+	// ndaDst and ndaLLAddr are the rtnetlink attributes of the IP and
+	// hardware address of a neighbor
+	ndaDst    = 1
+	ndaLLAddr = 2
+
+	// nudIncomplete and nudFailed are the states of neighbors without a
+	// resolved hardware address
+	nudIncomplete = 0x01
+	nudFailed     = 0x20
+)
+
+// rtaAlign returns l aligned to the rtnetlink attribute alignment
+func rtaAlign(l int) int {
+	return (l + syscall.RTA_ALIGNTO - 1) &^ (syscall.RTA_ALIGNTO - 1)
+}
+
+// parseNeighMessages parses the rtnetlink neighbor messages in b, names maps
+// interface indexes to interface names, unresolved entries are skipped
+func parseNeighMessages(b []byte, names map[int]string) ([]*Neighbor, error) {
+	msgs, err := syscall.ParseNetlinkMessage(b)
+	if err != nil {
+		return nil, err
+	}
+	ne := binary.NativeEndian
+	var neighbors []*Neighbor
+	for _, m := range msgs {
+		if m.Header.Type != syscall.RTM_NEWNEIGH ||
+			len(m.Data) < sizeofNdMsg {
+			continue
+		}
+		index := int(int32(ne.Uint32(m.Data[4:8])))
+		state := ne.Uint16(m.Data[8:10])
+		if state&(nudIncomplete|nudFailed) != 0 {
+			continue
+		}
+
+		n := &Neighbor{Device: names[index]}
+		for a := m.Data[sizeofNdMsg:]; len(a) >= syscall.SizeofRtAttr; {
+			l := int(ne.Uint16(a[0:2]))
+			if l < syscall.SizeofRtAttr || l > len(a) {
+				return nil, syscall.EINVAL
+			}
+			value := a[syscall.SizeofRtAttr:l]
+			switch ne.Uint16(a[2:4]) {
+			case ndaDst:
+				n.IP, _ = netip.AddrFromSlice(value)
+			case ndaLLAddr:
+				n.MAC = net.HardwareAddr(value).String()
+			}
+			a = a[min(len(a), rtaAlign(l)):]
+		}
+		if !n.IP.IsValid() || n.MAC == "" ||
+			n.MAC == "00:00:00:00:00:00" {
+			continue
+		}
+		neighbors = append(neighbors, n)
+	}
+	return neighbors, nil
+}
+
+// NDP returns the entries of the IPv6 neighbor cache
+func NDP() ([]*Neighbor, error) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int]string)
+	for _, ifi := range ifaces {
+		names[ifi.Index] = ifi.Name
+	}
+	rib, err := syscall.NetlinkRIB(syscall.RTM_GETNEIGH, syscall.AF_INET6)
+	if err != nil {
+		return nil, os.NewSyscallError("netlinkrib", err)
+	}
+	return parseNeighMessages(rib, names)
+}
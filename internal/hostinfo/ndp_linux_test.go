@@ -0,0 +1,60 @@
+package hostinfo
+
+import (
+	"encoding/binary"
+	"net"
+	"net/netip"
+	"syscall"
+	"testing"
+)
+
+// neighMessage returns a rtnetlink neighbor message with index, state and
+// the attributes ip and hw
+func neighMessage(index int, state uint16, ip netip.Addr,
+	hw net.HardwareAddr) []byte {
+	ne := binary.NativeEndian
+	attr := func(typ uint16, value []byte) []byte {
+		a := make([]byte, rtaAlign(syscall.SizeofRtAttr+len(value)))
+		ne.PutUint16(a[0:2], uint16(syscall.SizeofRtAttr+len(value)))
+		ne.PutUint16(a[2:4], typ)
+		copy(a[syscall.SizeofRtAttr:], value)
+		return a
+	}
+
+	nd := make([]byte, sizeofNdMsg)
+	nd[0] = syscall.AF_INET6
+	ne.PutUint32(nd[4:8], uint32(index))
+	ne.PutUint16(nd[8:10], state)
+	nd = append(nd, attr(ndaDst, ip.AsSlice())...)
+	nd = append(nd, attr(ndaLLAddr, hw)...)
+
+	b := make([]byte, syscall.SizeofNlMsghdr, syscall.SizeofNlMsghdr+len(nd))
+	ne.PutUint32(b[0:4], uint32(syscall.SizeofNlMsghdr+len(nd)))
+	ne.PutUint16(b[4:6], syscall.RTM_NEWNEIGH)
+	return append(b, nd...)
+}
+
+// TestParseNeighMessages tests parseNeighMessages
+func TestParseNeighMessages(t *testing.T) {
+	hw, _ := net.ParseMAC("52:54:00:11:22:33")
+	var b []byte
+	b = append(b, neighMessage(2, 0x02, netip.MustParseAddr("fe80::1"),
+		hw)...)
+	b = append(b, neighMessage(2, nudFailed,
+		netip.MustParseAddr("fe80::2"), hw)...)
+	b = append(b, neighMessage(3, 0x04, netip.MustParseAddr("fe80::3"),
+		make(net.HardwareAddr, 6))...)
+
+	neighbors, err := parseNeighMessages(b, map[int]string{2: "eth0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(neighbors) != 1 {
+		t.Fatalf("got %d neighbors, want 1", len(neighbors))
+	}
+	n := neighbors[0]
+	if n.IP.String() != "fe80::1" || n.MAC != "52:54:00:11:22:33" ||
+		n.Device != "eth0" {
+		t.Errorf("got %v, want fe80::1 52:54:00:11:22:33 eth0", n)
+	}
+}
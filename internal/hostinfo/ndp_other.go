@@ -0,0 +1,8 @@
+//go:build !linux
+
+package hostinfo
+
+// NDP returns the entries of the IPv6 neighbor cache
+func NDP() ([]*Neighbor, error) {
+	return nil, ErrNotSupported
+}
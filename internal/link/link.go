@@ -0,0 +1,14 @@
+// Package link reads and sets the hardware addresses of network interfaces
+package link
+
+import "errors"
+
+var (
+	// ErrNotSupported is returned if changing addresses is not supported
+	// on the operating system
+	ErrNotSupported = errors.New("not supported on this operating system")
+
+	// ErrNoPermanentAddr is returned if an interface has no permanent
+	// hardware address, e.g., virtual interfaces
+	ErrNoPermanentAddr = errors.New("no permanent hardware address")
+)
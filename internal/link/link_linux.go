@@ -0,0 +1,251 @@
+package link
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+const (
+	// iflaPermAddress is the rtnetlink attribute of the permanent
+	// hardware address, it is missing in the syscall package
+	iflaPermAddress = 54
+
+	// siocEthtool is the ioctl request of ethtool
+	siocEthtool = 0x8946
+
+	// ethtoolGPermAddr is the ethtool command to get the permanent
+	// hardware address
+	ethtoolGPermAddr = 0x20
+
+	// maxAddrLen is the maximum hardware address length of ethtool
+	maxAddrLen = 32
+
+	// sysNet is the directory with the network interfaces in sysfs
+	sysNet = "/sys/class/net"
+)
+
+// rtaAlign returns l aligned to the rtnetlink attribute alignment
+func rtaAlign(l int) int {
+	return (l + syscall.RTA_ALIGNTO - 1) &^ (syscall.RTA_ALIGNTO - 1)
+}
+
+// setLinkMessage returns the rtnetlink message that sets the hardware
+// address of the interface with index to hw
+func setLinkMessage(index int, hw net.HardwareAddr, seq uint32) []byte {
+	attrLen := syscall.SizeofRtAttr + len(hw)
+	l := syscall.SizeofNlMsghdr + syscall.SizeofIfInfomsg + rtaAlign(attrLen)
+	b := make([]byte, l)
+	ne := binary.NativeEndian
+
+	// netlink header
+	ne.PutUint32(b[0:4], uint32(l))
+	ne.PutUint16(b[4:6], syscall.RTM_SETLINK)
+	ne.PutUint16(b[6:8], syscall.NLM_F_REQUEST|syscall.NLM_F_ACK)
+	ne.PutUint32(b[8:12], seq)
+
+	// interface info message, family is AF_UNSPEC
+	info := b[syscall.SizeofNlMsghdr:]
+	ne.PutUint32(info[4:8], uint32(index))
+
+	// address attribute
+	attr := info[syscall.SizeofIfInfomsg:]
+	ne.PutUint16(attr[0:2], uint16(attrLen))
+	ne.PutUint16(attr[2:4], syscall.IFLA_ADDRESS)
+	copy(attr[syscall.SizeofRtAttr:], hw)
+
+	return b
+}
+
+// parseAck parses the rtnetlink acknowledgement in b for the message with
+// seq and returns the error it contains
+func parseAck(b []byte, seq uint32) (done bool, err error) {
+	msgs, err := syscall.ParseNetlinkMessage(b)
+	if err != nil {
+		return false, err
+	}
+	for _, m := range msgs {
+		if m.Header.Seq != seq || m.Header.Type != syscall.NLMSG_ERROR {
+			continue
+		}
+		if len(m.Data) < 4 {
+			return true, syscall.EINVAL
+		}
+		if errno := int32(binary.NativeEndian.Uint32(m.Data)); errno != 0 {
+			return true, syscall.Errno(-errno)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// request sends the rtnetlink request in msg with seq and waits for its
+// acknowledgement
+func request(msg []byte, seq uint32) error {
+	fd, err := syscall.Socket(syscall.AF_NETLINK,
+		syscall.SOCK_RAW|syscall.SOCK_CLOEXEC, syscall.NETLINK_ROUTE)
+	if err != nil {
+		return os.NewSyscallError("socket", err)
+	}
+	defer syscall.Close(fd)
+	sa := &syscall.SockaddrNetlink{Family: syscall.AF_NETLINK}
+	if err := syscall.Bind(fd, sa); err != nil {
+		return os.NewSyscallError("bind", err)
+	}
+
+	if err := syscall.Sendto(fd, msg, 0, sa); err != nil {
+		return os.NewSyscallError("sendto", err)
+	}
+	buf := make([]byte, os.Getpagesize())
+	for {
+		n, _, err := syscall.Recvfrom(fd, buf, 0)
+		if err != nil {
+			return os.NewSyscallError("recvfrom", err)
+		}
+		if done, err := parseAck(buf[:n], seq); done || err != nil {
+			return err
+		}
+	}
+}
+
+// SetAddr sets the hardware address of the interface with name to hw via
+// rtnetlink
+func SetAddr(name string, hw net.HardwareAddr) error {
+	ifi, err := net.InterfaceByName(name)
+	if err != nil {
+		return err
+	}
+
+	const seq = 1
+	if err := request(setLinkMessage(ifi.Index, hw, seq), seq); err != nil {
+		return fmt.Errorf("%s: set address: %w", name, err)
+	}
+	return nil
+}
+
+// netlinkPermAddr returns the permanent hardware address of the interface
+// with index from rtnetlink, it requires Linux 5.6 or newer
+func netlinkPermAddr(index int) (net.HardwareAddr, error) {
+	rib, err := syscall.NetlinkRIB(syscall.RTM_GETLINK, syscall.AF_UNSPEC)
+	if err != nil {
+		return nil, err
+	}
+	return parsePermAddr(rib, index)
+}
+
+// parsePermAddr returns the permanent hardware address of the interface
+// with index in the rtnetlink link messages in rib
+func parsePermAddr(rib []byte, index int) (net.HardwareAddr, error) {
+	msgs, err := syscall.ParseNetlinkMessage(rib)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range msgs {
+		if m.Header.Type != syscall.RTM_NEWLINK ||
+			len(m.Data) < syscall.SizeofIfInfomsg {
+			continue
+		}
+		info := (*syscall.IfInfomsg)(unsafe.Pointer(&m.Data[0]))
+		if int(info.Index) != index {
+			continue
+		}
+		attrs, err := syscall.ParseNetlinkRouteAttr(&m)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range attrs {
+			if a.Attr.Type == iflaPermAddress {
+				return net.HardwareAddr(a.Value), nil
+			}
+		}
+	}
+	return nil, ErrNoPermanentAddr
+}
+
+// permAddr is the ethtool_perm_addr struct
+type permAddr struct {
+	cmd  uint32
+	size uint32
+	data [maxAddrLen]byte
+}
+
+// ifreq is the ifreq struct with a pointer to ethtool data
+type ifreq struct {
+	name [syscall.IFNAMSIZ]byte
+	data unsafe.Pointer
+	_    [16]byte
+}
+
+// ethtoolPermAddr returns the permanent hardware address of the interface
+// with name from ethtool
+func ethtoolPermAddr(name string) (net.HardwareAddr, error) {
+	fd, err := syscall.Socket(syscall.AF_INET,
+		syscall.SOCK_DGRAM|syscall.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, os.NewSyscallError("socket", err)
+	}
+	defer syscall.Close(fd)
+
+	perm := permAddr{cmd: ethtoolGPermAddr, size: maxAddrLen}
+	req := ifreq{data: unsafe.Pointer(&perm)}
+	copy(req.name[:syscall.IFNAMSIZ-1], name)
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, uintptr(fd),
+		siocEthtool, uintptr(unsafe.Pointer(&req)))
+	if errno != 0 {
+		return nil, os.NewSyscallError("ioctl", errno)
+	}
+	return net.HardwareAddr(perm.data[:min(perm.size, maxAddrLen)]), nil
+}
+
+// sysfsPermAddr returns the current hardware address of the interface with
+// name from sysfs in sys if its address assignment type is permanent
+func sysfsPermAddr(sys, name string) (net.HardwareAddr, error) {
+	dir := filepath.Join(sys, name)
+	t, err := os.ReadFile(filepath.Join(dir, "addr_assign_type"))
+	if err != nil {
+		return nil, err
+	}
+	// NET_ADDR_PERM is 0
+	if strings.TrimSpace(string(t)) != "0" {
+		return nil, ErrNoPermanentAddr
+	}
+	a, err := os.ReadFile(filepath.Join(dir, "address"))
+	if err != nil {
+		return nil, err
+	}
+	return net.ParseMAC(strings.TrimSpace(string(a)))
+}
+
+// zero returns whether hw is empty or all zero
+func zero(hw net.HardwareAddr) bool {
+	for _, b := range hw {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// PermanentAddr returns the permanent hardware address of the interface
+// with name, it tries rtnetlink and ethtool first and falls back to sysfs
+func PermanentAddr(name string) (net.HardwareAddr, error) {
+	ifi, err := net.InterfaceByName(name)
+	if err != nil {
+		return nil, err
+	}
+	if hw, err := netlinkPermAddr(ifi.Index); err == nil && !zero(hw) {
+		return hw, nil
+	}
+	if hw, err := ethtoolPermAddr(name); err == nil && !zero(hw) {
+		return hw, nil
+	}
+	if hw, err := sysfsPermAddr(sysNet, name); err == nil && !zero(hw) {
+		return hw, nil
+	}
+	return nil, fmt.Errorf("%s: %w", name, ErrNoPermanentAddr)
+}
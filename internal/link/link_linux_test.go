@@ -0,0 +1,240 @@
+package link
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"syscall"
+	"testing"
+)
+
+// TestSetLinkMessage tests setLinkMessage
+func TestSetLinkMessage(t *testing.T) {
+	hw, _ := net.ParseMAC("02:11:22:33:44:55")
+	b := setLinkMessage(7, hw, 3)
+
+	msgs, err := syscall.ParseNetlinkMessage(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 1 {
+		t.Fatalf("got %d messages, want 1", len(msgs))
+	}
+	m := msgs[0]
+	if m.Header.Type != syscall.RTM_SETLINK || m.Header.Seq != 3 {
+		t.Errorf("got type %d seq %d, want type %d seq 3",
+			m.Header.Type, m.Header.Seq, syscall.RTM_SETLINK)
+	}
+	if got := binary.NativeEndian.Uint32(m.Data[4:8]); got != 7 {
+		t.Errorf("got index %d, want 7", got)
+	}
+
+	// ParseNetlinkRouteAttr only accepts link messages with this type
+	m.Header.Type = syscall.RTM_NEWLINK
+	attrs, err := syscall.ParseNetlinkRouteAttr(&m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attrs) != 1 || attrs[0].Attr.Type != syscall.IFLA_ADDRESS ||
+		!bytes.Equal(attrs[0].Value, hw) {
+		t.Errorf("got %v, want address attribute %s", attrs, hw)
+	}
+}
+
+// ackMessage returns a netlink ack message with seq and errno
+func ackMessage(seq uint32, errno int32) []byte {
+	b := make([]byte, syscall.SizeofNlMsghdr+4+syscall.SizeofNlMsghdr)
+	ne := binary.NativeEndian
+	ne.PutUint32(b[0:4], uint32(len(b)))
+	ne.PutUint16(b[4:6], syscall.NLMSG_ERROR)
+	ne.PutUint32(b[8:12], seq)
+	ne.PutUint32(b[16:20], uint32(errno))
+	return b
+}
+
+// TestParseAck tests parseAck
+func TestParseAck(t *testing.T) {
+	done, err := parseAck(ackMessage(1, 0), 1)
+	if !done || err != nil {
+		t.Errorf("got %t %v, want true nil", done, err)
+	}
+
+	done, err = parseAck(ackMessage(1, -int32(syscall.EPERM)), 1)
+	if !done || !errors.Is(err, syscall.EPERM) {
+		t.Errorf("got %t %v, want true %v", done, err, syscall.EPERM)
+	}
+
+	done, _ = parseAck(ackMessage(2, 0), 1)
+	if done {
+		t.Errorf("got ack for wrong sequence number")
+	}
+}
+
+// rtnetlink link info attributes, they are missing in the syscall package
+const (
+	iflaInfoKind = 1
+	iflaInfoData = 2
+	vethInfoPeer = 1
+)
+
+// rtAttr returns the rtnetlink attribute typ with data, padded to the
+// attribute alignment
+func rtAttr(typ uint16, data ...[]byte) []byte {
+	l := syscall.SizeofRtAttr
+	for _, d := range data {
+		l += len(d)
+	}
+	b := make([]byte, syscall.SizeofRtAttr, rtaAlign(l))
+	binary.NativeEndian.PutUint16(b[0:2], uint16(l))
+	binary.NativeEndian.PutUint16(b[2:4], typ)
+	for _, d := range data {
+		b = append(b, d...)
+	}
+	return b[:rtaAlign(l)]
+}
+
+// linkMessage returns the rtnetlink link message with flags and seq for the
+// interface with index and the attributes in attrs
+func linkMessage(flags uint16, seq uint32, index int,
+	attrs ...[]byte) []byte {
+	info := make([]byte, syscall.SizeofIfInfomsg)
+	binary.NativeEndian.PutUint32(info[4:8], uint32(index))
+	body := append(info, bytes.Join(attrs, nil)...)
+
+	l := syscall.SizeofNlMsghdr + len(body)
+	b := make([]byte, syscall.SizeofNlMsghdr, l)
+	ne := binary.NativeEndian
+	ne.PutUint32(b[0:4], uint32(l))
+	ne.PutUint16(b[4:6], syscall.RTM_NEWLINK)
+	ne.PutUint16(b[6:8], flags)
+	ne.PutUint32(b[8:12], seq)
+	return append(b, body...)
+}
+
+// newLinkMessage returns the rtnetlink message that creates the link with
+// name and kind, the veth kind gets a peer named peer
+func newLinkMessage(name, kind, peer string, seq uint32) []byte {
+	linkInfo := [][]byte{rtAttr(iflaInfoKind, []byte(kind))}
+	if kind == "veth" {
+		peerInfo := rtAttr(vethInfoPeer,
+			make([]byte, syscall.SizeofIfInfomsg),
+			rtAttr(syscall.IFLA_IFNAME, []byte(peer+"\x00")))
+		linkInfo = append(linkInfo, rtAttr(iflaInfoData, peerInfo))
+	}
+	return linkMessage(syscall.NLM_F_REQUEST|syscall.NLM_F_ACK|
+		syscall.NLM_F_CREATE|syscall.NLM_F_EXCL, seq, 0,
+		rtAttr(syscall.IFLA_IFNAME, []byte(name+"\x00")),
+		rtAttr(syscall.IFLA_LINKINFO, linkInfo...))
+}
+
+// TestParsePermAddr tests parsePermAddr
+func TestParsePermAddr(t *testing.T) {
+	hw, _ := net.ParseMAC("00:11:22:33:44:55")
+	rib := append(linkMessage(0, 1, 7,
+		rtAttr(syscall.IFLA_IFNAME, []byte("eth0\x00")),
+		rtAttr(iflaPermAddress, hw)),
+		linkMessage(0, 1, 8,
+			rtAttr(syscall.IFLA_IFNAME, []byte("veth0\x00")))...)
+
+	got, err := parsePermAddr(rib, 7)
+	if err != nil || !bytes.Equal(got, hw) {
+		t.Errorf("got %s %v, want %s", got, err, hw)
+	}
+	for _, index := range []int{8, 9} {
+		_, err := parsePermAddr(rib, index)
+		if !errors.Is(err, ErrNoPermanentAddr) {
+			t.Errorf("%d: got %v, want %v", index, err,
+				ErrNoPermanentAddr)
+		}
+	}
+}
+
+// TestSysfsPermAddr tests sysfsPermAddr with a fake sysfs directory
+func TestSysfsPermAddr(t *testing.T) {
+	sys := t.TempDir()
+	for _, iface := range []struct {
+		name, assignType, address string
+	}{
+		{"eth0", "0", "00:11:22:33:44:55"},
+		{"veth0", "1", "02:11:22:33:44:55"},
+	} {
+		dir := filepath.Join(sys, iface.name)
+		if err := os.Mkdir(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		for file, content := range map[string]string{
+			"addr_assign_type": iface.assignType + "\n",
+			"address":          iface.address + "\n",
+		} {
+			err := os.WriteFile(filepath.Join(dir, file),
+				[]byte(content), 0o644)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	hw, err := sysfsPermAddr(sys, "eth0")
+	if err != nil || hw.String() != "00:11:22:33:44:55" {
+		t.Errorf("got %s %v, want 00:11:22:33:44:55", hw, err)
+	}
+	if _, err := sysfsPermAddr(sys, "veth0"); !errors.Is(err,
+		ErrNoPermanentAddr) {
+		t.Errorf("got %v, want %v", err, ErrNoPermanentAddr)
+	}
+	if _, err := sysfsPermAddr(sys, "eth9"); err == nil {
+		t.Errorf("got nil, want error")
+	}
+}
+
+// TestSetAddrNamespace tests SetAddr on a dummy or veth link in a new
+// network namespace, it requires CAP_NET_ADMIN
+func TestSetAddrNamespace(t *testing.T) {
+	// the namespace belongs to this thread only, it is never unlocked so
+	// the thread exits with the test
+	runtime.LockOSThread()
+	if err := syscall.Unshare(syscall.CLONE_NEWNET); err != nil {
+		t.Skipf("cannot create network namespace: %v", err)
+	}
+
+	// not every kernel has dummy links, fall back to veth
+	const name = "ratest0"
+	err := request(newLinkMessage(name, "dummy", "", 1), 1)
+	if err != nil {
+		err = request(newLinkMessage(name, "veth", "ratest1", 2), 2)
+	}
+	if err != nil {
+		t.Skipf("cannot create dummy or veth link: %v", err)
+	}
+
+	hw, _ := net.ParseMAC("02:00:5e:10:00:01")
+	if err := SetAddr(name, hw); err != nil {
+		t.Fatal(err)
+	}
+	ifi, err := net.InterfaceByName(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(ifi.HardwareAddr, hw) {
+		t.Errorf("got %s, want %s", ifi.HardwareAddr, hw)
+	}
+
+	// invalid address length
+	if err := SetAddr(name, hw[:3]); !errors.Is(err, syscall.EINVAL) {
+		t.Errorf("got %v, want %v", err, syscall.EINVAL)
+	}
+
+	// virtual links have random addresses, not permanent ones
+	if _, err := PermanentAddr(name); !errors.Is(err, ErrNoPermanentAddr) {
+		t.Errorf("got %v, want %v", err, ErrNoPermanentAddr)
+	}
+
+	// unknown interface
+	if err := SetAddr("ratest9", hw); err == nil {
+		t.Errorf("got nil, want error")
+	}
+}
@@ -0,0 +1,16 @@
+//go:build !linux
+
+package link
+
+import "net"
+
+// SetAddr sets the hardware address of the interface with name to hw
+func SetAddr(name string, hw net.HardwareAddr) error {
+	return ErrNotSupported
+}
+
+// PermanentAddr returns the permanent hardware address of the interface
+// with name
+func PermanentAddr(name string) (net.HardwareAddr, error) {
+	return nil, ErrNotSupported
+}
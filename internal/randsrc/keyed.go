@@ -0,0 +1,51 @@
+package randsrc
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"io"
+)
+
+// keyed reads pseudo random bytes derived from a secret and a name
+type keyed struct {
+	mac     hash.Hash
+	message []byte
+	counter uint64
+
+	// buf contains bytes left over from the last read
+	buf []byte
+}
+
+// Read fills p with bytes derived from the secret and name, it never fails
+func (k *keyed) Read(p []byte) (int, error) {
+	for i := range p {
+		if len(k.buf) == 0 {
+			k.mac.Reset()
+			k.mac.Write(k.message)
+			k.mac.Write(binary.BigEndian.AppendUint64(nil, k.counter))
+			k.buf = k.mac.Sum(nil)
+			k.counter++
+		}
+		p[i] = k.buf[0]
+		k.buf = k.buf[1:]
+	}
+	return len(p), nil
+}
+
+// Keyed returns a reader that reads bytes derived from secret and name, the
+// bytes are the HMAC-SHA256 with secret of label, name and a block counter,
+// so the same secret, label and name always result in the same bytes. The
+// label separates the bytes of different uses of the same name
+func Keyed(secret []byte, label, name string) io.Reader {
+	var message []byte
+	message = binary.BigEndian.AppendUint64(message, uint64(len(label)))
+	message = append(message, label...)
+	message = binary.BigEndian.AppendUint64(message, uint64(len(name)))
+	message = append(message, name...)
+	return &keyed{
+		mac:     hmac.New(sha256.New, secret),
+		message: message,
+	}
+}
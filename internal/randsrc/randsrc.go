@@ -0,0 +1,36 @@
+// Package randsrc converts sources of random numbers to readers of random
+// bytes and derives deterministic bytes from a secret
+package randsrc
+
+import (
+	"encoding/binary"
+	"io"
+	"math/rand/v2"
+)
+
+// reader reads random bytes from a random number source
+type reader struct {
+	src rand.Source
+
+	// buf contains random bytes left over from the last read
+	buf [8]byte
+	n   int
+}
+
+// Read fills p with random bytes from the source, it never fails
+func (r *reader) Read(p []byte) (int, error) {
+	for i := range p {
+		if r.n == 0 {
+			binary.LittleEndian.PutUint64(r.buf[:], r.src.Uint64())
+			r.n = len(r.buf)
+		}
+		p[i] = r.buf[len(r.buf)-r.n]
+		r.n--
+	}
+	return len(p), nil
+}
+
+// Reader returns a reader that reads random bytes from src
+func Reader(src rand.Source) io.Reader {
+	return &reader{src: src}
+}
@@ -0,0 +1,57 @@
+package randsrc
+
+import (
+	"bytes"
+	"math/rand/v2"
+	"testing"
+)
+
+// TestReader tests Reader
+func TestReader(t *testing.T) {
+	// same seed, same bytes regardless of read sizes
+	r1 := Reader(rand.NewPCG(1, 2))
+	r2 := Reader(rand.NewPCG(1, 2))
+
+	b1 := make([]byte, 20)
+	if _, err := r1.Read(b1); err != nil {
+		t.Fatal(err)
+	}
+	b2 := make([]byte, 20)
+	for i := 0; i < len(b2); i += 5 {
+		if _, err := r2.Read(b2[i : i+5]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !bytes.Equal(b1, b2) {
+		t.Errorf("got %x, want %x", b2, b1)
+	}
+}
+
+// TestKeyed tests Keyed
+func TestKeyed(t *testing.T) {
+	read := func(secret, label, name string) []byte {
+		b := make([]byte, 40)
+		if _, err := Keyed([]byte(secret), label, name).Read(b); err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	// same secret, label and name, same bytes
+	want := read("secret", "mac", "vm1")
+	if got := read("secret", "mac", "vm1"); !bytes.Equal(got, want) {
+		t.Errorf("got %x, want %x", got, want)
+	}
+
+	// different secret, label or name, different bytes
+	for _, got := range [][]byte{
+		read("other", "mac", "vm1"),
+		read("secret", "ipv4", "vm1"),
+		read("secret", "mac", "vm2"),
+		read("secret", "macv", "m1"),
+	} {
+		if bytes.Equal(got, want) {
+			t.Errorf("got %x, want different bytes", got)
+		}
+	}
+}
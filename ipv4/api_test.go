@@ -0,0 +1,62 @@
+package ipv4_test
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/netip"
+
+	"github.com/hwipl/random-addr/ipv4"
+	"github.com/hwipl/random-addr/mac"
+)
+
+// The following assignments make sure the exported API of the package does
+// not change by accident, they fail to compile if it does
+var (
+	_ fmt.Stringer = (*ipv4.IPv4)(nil)
+	_ error        = (*ipv4.ParseError)(nil)
+	_ error        = ipv4.ErrInvalidSyntax
+	_ error        = ipv4.ErrNotIPv4
+	_ error        = ipv4.ErrNotMulticast
+	_ error        = ipv4.ErrPrefixLength
+	_ error        = ipv4.ErrRandomSource
+
+	_ func(io.Reader) *ipv4.Generator      = ipv4.NewGenerator
+	_ func(rand.Source) *ipv4.Generator    = ipv4.NewSourceGenerator
+	_ func([]byte, string) *ipv4.Generator = ipv4.NewKeyedGenerator
+	_ func() (*ipv4.IPv4, error)           = ipv4.TryRandom
+	_ func() *ipv4.IPv4                    = ipv4.Random
+	_ func(string) (*ipv4.IPv4, error)     = ipv4.TryParse
+	_ func(string) *ipv4.IPv4              = ipv4.Parse
+	_ func(*mac.MAC) ([]*ipv4.IPv4, error) = ipv4.MulticastGroups
+
+	_ func(*ipv4.ParseError) string             = (*ipv4.ParseError).Error
+	_ func(*ipv4.ParseError) error              = (*ipv4.ParseError).Unwrap
+	_ func(*ipv4.Generator) (*ipv4.IPv4, error) = (*ipv4.Generator).Random
+	_ func(*ipv4.IPv4) netip.Addr               = (*ipv4.IPv4).Addr
+	_ func(*ipv4.IPv4) []byte                   = (*ipv4.IPv4).Bytes
+	_ func(*ipv4.IPv4) int                      = (*ipv4.IPv4).BitLen
+	_ func(*ipv4.IPv4) netip.Prefix             = (*ipv4.IPv4).Prefix
+	_ func(*ipv4.IPv4) string                   = (*ipv4.IPv4).Decimal
+	_ func(*ipv4.IPv4) string                   = (*ipv4.IPv4).Binary
+	_ func(*ipv4.IPv4) string                   = (*ipv4.IPv4).Network
+	_ func(*ipv4.IPv4) string                   = (*ipv4.IPv4).Host
+	_ func(*ipv4.IPv4) bool                     = (*ipv4.IPv4).Loopback
+	_ func(*ipv4.IPv4) bool                     = (*ipv4.IPv4).Private
+	_ func(*ipv4.IPv4) bool                     = (*ipv4.IPv4).Unspecified
+	_ func(*ipv4.IPv4) bool                     = (*ipv4.IPv4).LinkLocal
+	_ func(*ipv4.IPv4) bool                     = (*ipv4.IPv4).Multicast
+	_ func(*ipv4.IPv4) bool                     = (*ipv4.IPv4).Broadcast
+	_ func(*ipv4.IPv4) bool                     = (*ipv4.IPv4).Unicast
+	_ func(*ipv4.IPv4) string                   = (*ipv4.IPv4).Type
+	_ func(*ipv4.IPv4) string                   = (*ipv4.IPv4).ExplainBin
+	_ func(*ipv4.IPv4) string                   = (*ipv4.IPv4).ExplainDecimal
+	_ func(*ipv4.IPv4) string                   = (*ipv4.IPv4).Table
+	_ func(*ipv4.IPv4) string                   = (*ipv4.IPv4).Explain
+	_ func(*ipv4.IPv4) string                   = (*ipv4.IPv4).String
+	_ func(*ipv4.IPv4, string) error            = (*ipv4.IPv4).TrySetPrefix
+	_ func(*ipv4.IPv4, string)                  = (*ipv4.IPv4).SetPrefix
+	_ func(*ipv4.IPv4, int) error               = (*ipv4.IPv4).TrySetPrefixLength
+	_ func(*ipv4.IPv4, int)                     = (*ipv4.IPv4).SetPrefixLength
+	_ func(*ipv4.IPv4) (*mac.MAC, error)        = (*ipv4.IPv4).MulticastMAC
+)
@@ -0,0 +1,12 @@
+// Package ipv4 parses, generates and explains IPv4 addresses.
+//
+// Random addresses are created with [Random] or with a [Generator] that reads
+// random bytes from a custom source and can be placed inside a prefix with
+// [IPv4.SetPrefix]. Addresses are parsed with [Parse]. Functions and methods
+// prefixed with Try return an error instead of exiting the program if
+// something goes wrong, e.g., [TryRandom], [TryParse] and
+// [IPv4.TrySetPrefix].
+//
+// The exported API of this package is stable and follows semantic
+// versioning.
+package ipv4
@@ -0,0 +1,44 @@
+package ipv4
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidSyntax is returned if an address or prefix string is
+	// malformed
+	ErrInvalidSyntax = errors.New("invalid syntax")
+
+	// ErrNotIPv4 is returned if an address is not an IPv4 address
+	ErrNotIPv4 = errors.New("not an IPv4 address")
+
+	// ErrPrefixLength is returned if a prefix length is out of range
+	ErrPrefixLength = errors.New("prefix length out of range")
+
+	// ErrRandomSource is returned if reading random bytes fails
+	ErrRandomSource = errors.New("cannot read random bytes")
+
+	// ErrNotMulticast is returned if an address is not a multicast
+	// address
+	ErrNotMulticast = errors.New("not a multicast address")
+)
+
+// ParseError is returned if parsing an address or prefix fails
+type ParseError struct {
+	// Input is the string that could not be parsed
+	Input string
+
+	// Err is the reason parsing failed
+	Err error
+}
+
+// Error returns e as string
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("ipv4: cannot parse %q: %v", e.Input, e.Err)
+}
+
+// Unwrap returns the reason parsing failed
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,36 @@
+package ipv4_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/hwipl/random-addr/ipv4"
+)
+
+func ExampleTryParse() {
+	ip, err := ipv4.TryParse("192.168.1.1/24")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(ip.Network())
+	fmt.Println(ip.Type())
+	// Output:
+	// 192.168.1.0
+	// private unicast
+}
+
+func ExampleIPv4_TrySetPrefix() {
+	g := ipv4.NewSourceGenerator(rand.NewPCG(1, 2))
+	ip, err := g.Random()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	if err := ip.TrySetPrefix("10.0.0.0/8"); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(ip.Network())
+	// Output: 10.0.0.0
+}
@@ -0,0 +1,49 @@
+package ipv4
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	mrand "math/rand/v2"
+
+	"github.com/hwipl/random-addr/internal/randsrc"
+)
+
+// defaultGenerator is the generator used by the package level Random
+// functions, it reads random bytes from crypto/rand
+var defaultGenerator = NewGenerator(rand.Reader)
+
+// Generator generates random IPv4 addresses from a source of random bytes
+type Generator struct {
+	r io.Reader
+}
+
+// Random returns a random IPv4 address or an error if reading random bytes
+// fails
+func (g *Generator) Random() (*IPv4, error) {
+	ip := &IPv4{}
+	_, err := io.ReadFull(g.r, ip.b[:])
+	if err != nil {
+		return nil, fmt.Errorf("ipv4: %w: %w", ErrRandomSource, err)
+	}
+
+	return ip, nil
+}
+
+// NewGenerator returns a new generator that reads random bytes from r
+func NewGenerator(r io.Reader) *Generator {
+	return &Generator{r: r}
+}
+
+// NewSourceGenerator returns a new generator that gets random bytes from the
+// random number source src
+func NewSourceGenerator(src mrand.Source) *Generator {
+	return NewGenerator(randsrc.Reader(src))
+}
+
+// NewKeyedGenerator returns a new generator that derives its bytes from
+// secret and name, so it always generates the same addresses for the same
+// secret and name, e.g., a stable address for each VM name
+func NewKeyedGenerator(secret []byte, name string) *Generator {
+	return NewGenerator(randsrc.Keyed(secret, "ipv4", name))
+}
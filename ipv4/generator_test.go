@@ -0,0 +1,68 @@
+package ipv4
+
+import (
+	"bytes"
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+// TestGeneratorRandom tests Random of Generator
+func TestGeneratorRandom(t *testing.T) {
+	// test same source, same addresses
+	g1 := NewSourceGenerator(rand.NewPCG(1, 2))
+	g2 := NewSourceGenerator(rand.NewPCG(1, 2))
+	for i := 0; i < 10; i++ {
+		a1, err := g1.Random()
+		if err != nil {
+			t.Fatal(err)
+		}
+		a2, err := g2.Random()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a1.String() != a2.String() {
+			t.Errorf("got %s, want %s", a2, a1)
+		}
+	}
+
+	// test reader
+	g := NewGenerator(bytes.NewReader(make([]byte, len(IPv4{}.b))))
+	a, err := g.Random()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.b != (IPv4{}).b {
+		t.Errorf("got %s, want zero address", a)
+	}
+
+	// test reader error
+	_, err = g.Random()
+	if !errors.Is(err, ErrRandomSource) {
+		t.Errorf("got %v, want %v", err, ErrRandomSource)
+	}
+}
+
+// TestKeyedGenerator tests NewKeyedGenerator
+func TestKeyedGenerator(t *testing.T) {
+	derive := func(name string) string {
+		ip, err := NewKeyedGenerator([]byte("secret"), name).Random()
+		if err != nil {
+			t.Fatal(err)
+		}
+		ip.SetPrefix("10.20.0.0/16")
+		return ip.String()
+	}
+
+	want := derive("vm1")
+	if got := derive("vm1"); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if got := derive("vm2"); got == want {
+		t.Errorf("got %s, want different address", got)
+	}
+	if !strings.HasPrefix(want, "10.20.") {
+		t.Errorf("got %s, want address in 10.20.0.0/16", want)
+	}
+}
@@ -1,16 +1,19 @@
 package ipv4
 
 import (
-	"crypto/rand"
 	"fmt"
 	"log"
 	"net/netip"
+	"strconv"
 	"strings"
 )
 
 const (
 	// bitsPerByte is the number of bits per byte
 	bitsPerByte = 8
+
+	// maxBits is the number of bits in an IPv4 address
+	maxBits = 32
 )
 
 // IPv4 is an IPv4 address
@@ -26,6 +29,17 @@ func (ip *IPv4) Addr() netip.Addr {
 	return netip.AddrFrom4(ip.b)
 }
 
+// Bytes returns ip as a byte slice
+func (ip *IPv4) Bytes() []byte {
+	b := ip.b
+	return b[:]
+}
+
+// BitLen returns the length of ip in bits
+func (ip *IPv4) BitLen() int {
+	return maxBits
+}
+
 // Prefix returns ip as Prefix
 func (ip *IPv4) Prefix() netip.Prefix {
 	return netip.PrefixFrom(ip.Addr(), ip.pl)
@@ -83,6 +97,11 @@ func (ip *IPv4) Unspecified() bool {
 	return ip.Addr().IsUnspecified()
 }
 
+// LinkLocal returns wether ip is a link-local address
+func (ip *IPv4) LinkLocal() bool {
+	return ip.Addr().IsLinkLocalUnicast()
+}
+
 // Multicast returns wether ip is a multicast address
 func (ip *IPv4) Multicast() bool {
 	return ip.Addr().IsMulticast()
@@ -251,17 +270,43 @@ Type: %s
 	)
 }
 
+// Table returns all information about the IP as a table in a string
+func (ip *IPv4) Table() string {
+	return fmt.Sprintf(
+		` ------------------------------------------------------
+| Decimal | %-42s |
+| Prefix  | %-42s |
+| Network | %-42s |
+| Host    | %-42s |
+| Binary  | %-42s |
+| Type    | %-42s |
+ ------------------------------------------------------`,
+		ip.Decimal(),
+		ip.Prefix(),
+		ip.Network(),
+		ip.Host(),
+		ip.Binary(),
+		ip.Type(),
+	)
+}
+
+// Explain returns an explanation of the IP and its structure as string,
+// it is ExplainDecimal and the MAC address of multicast groups
+func (ip *IPv4) Explain() string {
+	return ip.ExplainDecimal() + ip.explainMulticast()
+}
+
 // String returns ip as String
 func (ip *IPv4) String() string {
 	return ip.Decimal()
 }
 
-// SetPrefix sets prefix in ip
-func (ip *IPv4) SetPrefix(prefix string) {
+// TrySetPrefix sets prefix in ip or returns an error if prefix is invalid
+func (ip *IPv4) TrySetPrefix(prefix string) error {
 	// parse prefix
-	p, err := netip.ParsePrefix(prefix)
+	p, err := parsePrefix(prefix)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
 	// get prefix bytes,
@@ -289,17 +334,43 @@ func (ip *IPv4) SetPrefix(prefix string) {
 
 	// set new prefix length
 	ip.pl = p.Bits()
+
+	return nil
+}
+
+// SetPrefix sets prefix in ip
+func (ip *IPv4) SetPrefix(prefix string) {
+	if err := ip.TrySetPrefix(prefix); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// TrySetPrefixLength sets prefix length of ip in number of bits or returns
+// an error if numBits is out of range
+func (ip *IPv4) TrySetPrefixLength(numBits int) error {
+	if numBits < 0 || numBits > maxBits {
+		return fmt.Errorf("ipv4: %w: %d", ErrPrefixLength, numBits)
+	}
+	ip.pl = numBits
+	return nil
 }
 
 // SetPrefixLength sets prefix length of ip in number of bits
 func (ip *IPv4) SetPrefixLength(numBits int) {
-	ip.pl = numBits
+	if err := ip.TrySetPrefixLength(numBits); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// TryRandom returns a random IPv4 address or an error if reading random
+// bytes fails
+func TryRandom() (*IPv4, error) {
+	return defaultGenerator.Random()
 }
 
 // Random returns a random IPv4 address
 func Random() *IPv4 {
-	ip := &IPv4{}
-	_, err := rand.Read(ip.b[:])
+	ip, err := TryRandom()
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -307,30 +378,75 @@ func Random() *IPv4 {
 	return ip
 }
 
-// Parse parses and returns the IPv4 address in s
-func Parse(s string) *IPv4 {
+// parseAddr parses the IPv4 address in s, input is the full string reported
+// in errors
+func parseAddr(input, s string) (netip.Addr, error) {
+	a, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}, &ParseError{Input: input, Err: ErrInvalidSyntax}
+	}
+	if !a.Is4() {
+		return netip.Addr{}, &ParseError{Input: input, Err: ErrNotIPv4}
+	}
+	return a, nil
+}
+
+// parsePrefix parses the IPv4 prefix in s
+func parsePrefix(s string) (netip.Prefix, error) {
+	p, err := netip.ParsePrefix(s)
+	if err == nil && p.Addr().Is4() {
+		return p, nil
+	}
+
+	// find out why parsing failed
+	addr, bits, ok := strings.Cut(s, "/")
+	if !ok {
+		return netip.Prefix{}, &ParseError{Input: s, Err: ErrInvalidSyntax}
+	}
+	if _, err := parseAddr(s, addr); err != nil {
+		return netip.Prefix{}, err
+	}
+	if n, err := strconv.Atoi(bits); err == nil && (n < 0 || n > maxBits) {
+		return netip.Prefix{}, &ParseError{Input: s, Err: ErrPrefixLength}
+	}
+	return netip.Prefix{}, &ParseError{Input: s, Err: ErrInvalidSyntax}
+}
+
+// TryParse parses and returns the IPv4 address in s or an error if s is not
+// a valid IPv4 address with optional prefix length
+func TryParse(s string) (*IPv4, error) {
 	ip := &IPv4{}
 
 	// parse ip with prefix
 	if strings.Contains(s, "/") {
-		p, err := netip.ParsePrefix(s)
+		p, err := parsePrefix(s)
 		if err != nil {
-			log.Fatal(err)
+			return nil, err
 		}
 
 		ip.b = p.Addr().As4()
 		ip.pl = p.Bits()
 
-		return ip
+		return ip, nil
 	}
 
 	// parse ip without prefix
-	a, err := netip.ParseAddr(s)
+	a, err := parseAddr(s, s)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	ip.b = a.As4()
 
+	return ip, nil
+}
+
+// Parse parses and returns the IPv4 address in s
+func Parse(s string) *IPv4 {
+	ip, err := TryParse(s)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	return ip
 }
@@ -1,6 +1,10 @@
 package ipv4
 
-import "testing"
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
 
 // TestDecimal tests Decimal of IPv4
 func TestDecimal(t *testing.T) {
@@ -48,6 +52,16 @@ func TestHost(t *testing.T) {
 	}
 }
 
+// TestLinkLocal tests LinkLocal of IPv4
+func TestLinkLocal(t *testing.T) {
+	if !Parse("169.254.1.2").LinkLocal() {
+		t.Errorf("169.254.1.2 is not link-local")
+	}
+	if Parse("192.0.2.1").LinkLocal() {
+		t.Errorf("192.0.2.1 is link-local")
+	}
+}
+
 // TestType tests Type of IPv4
 func TestType(t *testing.T) {
 	// test loopback
@@ -153,3 +167,71 @@ func TestParse(t *testing.T) {
 		t.Errorf("got %s, want %s", got, want)
 	}
 }
+
+// TestTryParse tests parsing of IPv4 addresses with errors
+func TestTryParse(t *testing.T) {
+	// test valid addresses
+	for _, want := range []string{"127.0.0.1", "127.0.0.1/8"} {
+		ip, err := TryParse(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := ip.Prefix().String(); got != want && got != want+"/0" {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+
+	// test invalid addresses
+	for _, test := range []struct {
+		s    string
+		want error
+	}{
+		{"invalid", ErrInvalidSyntax},
+		{"127.0.0.1/x", ErrInvalidSyntax},
+		{"fe80::1", ErrNotIPv4},
+		{"fe80::1/8", ErrNotIPv4},
+		{"127.0.0.1/33", ErrPrefixLength},
+	} {
+		_, err := TryParse(test.s)
+		if !errors.Is(err, test.want) {
+			t.Errorf("%s: got %v, want %v", test.s, err, test.want)
+		}
+	}
+}
+
+// TestTrySetPrefix tests TrySetPrefix of IPv4 with errors
+func TestTrySetPrefix(t *testing.T) {
+	ip := &IPv4{}
+	if err := ip.TrySetPrefix("fe80::1/8"); !errors.Is(err, ErrNotIPv4) {
+		t.Errorf("got %v, want %v", err, ErrNotIPv4)
+	}
+	if err := ip.TrySetPrefix("127.0.0.1/33"); !errors.Is(err, ErrPrefixLength) {
+		t.Errorf("got %v, want %v", err, ErrPrefixLength)
+	}
+}
+
+// TestTrySetPrefixLength tests TrySetPrefixLength of IPv4 with errors
+func TestTrySetPrefixLength(t *testing.T) {
+	ip := &IPv4{}
+	for _, bits := range []int{-1, maxBits + 1} {
+		if err := ip.TrySetPrefixLength(bits); !errors.Is(err, ErrPrefixLength) {
+			t.Errorf("%d: got %v, want %v", bits, err, ErrPrefixLength)
+		}
+	}
+	if err := ip.TrySetPrefixLength(maxBits); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestBytes tests Bytes of IPv4
+func TestBytes(t *testing.T) {
+	ip := Parse("192.0.2.1")
+	want := []byte{192, 0, 2, 1}
+	got := ip.Bytes()
+	if !bytes.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if ip.BitLen() != len(want)*8 {
+		t.Errorf("got %d, want %d", ip.BitLen(), len(want)*8)
+	}
+}
@@ -0,0 +1,55 @@
+package ipv4
+
+import (
+	"fmt"
+
+	"github.com/hwipl/random-addr/mac"
+)
+
+// multicastOUI is the OUI of MAC addresses of IPv4 multicast groups
+var multicastOUI = [3]byte{0x01, 0x00, 0x5e}
+
+// MulticastMAC returns the Ethernet MAC address of the multicast group ip,
+// it is 01:00:5e followed by the low 23 bits of ip
+func (ip *IPv4) MulticastMAC() (*mac.MAC, error) {
+	if !ip.Multicast() {
+		return nil, fmt.Errorf("ipv4: %w: %s", ErrNotMulticast, ip)
+	}
+	m := &mac.MAC{}
+	m.SetOUI(multicastOUI)
+	m.SetNIC([3]byte{ip.b[1] & 0x7f, ip.b[2], ip.b[3]})
+	return m, nil
+}
+
+// MulticastGroups returns the 32 IPv4 multicast groups that are mapped to
+// the Ethernet MAC address m, because only the low 23 bits of a group are
+// copied into the MAC
+func MulticastGroups(m *mac.MAC) ([]*IPv4, error) {
+	b := m.Bytes()
+	if [3]byte(b[:3]) != multicastOUI || b[3]&0x80 != 0 {
+		return nil, fmt.Errorf("ipv4: %w: %s is not mapped from IPv4",
+			ErrNotMulticast, m)
+	}
+
+	// the 5 bits after the 1110 multicast prefix are not in the MAC
+	groups := make([]*IPv4, 0, 32)
+	for i := byte(0); i < 32; i++ {
+		groups = append(groups, &IPv4{b: [4]byte{
+			0xe0 | i>>1,
+			i&1<<7 | b[3],
+			b[4],
+			b[5],
+		}})
+	}
+	return groups, nil
+}
+
+// explainMulticast returns the Ethernet MAC address of a multicast ip as
+// string for Explain
+func (ip *IPv4) explainMulticast() string {
+	m, err := ip.MulticastMAC()
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("MAC:  %s\n", m)
+}
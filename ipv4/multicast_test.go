@@ -0,0 +1,71 @@
+package ipv4
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hwipl/random-addr/mac"
+)
+
+// TestMulticastMAC tests MulticastMAC
+func TestMulticastMAC(t *testing.T) {
+	for _, test := range []struct {
+		ip   string
+		want string
+	}{
+		{"224.0.0.251", "01:00:5e:00:00:fb"},
+		{"239.129.2.3", "01:00:5e:01:02:03"},
+		{"239.255.255.250", "01:00:5e:7f:ff:fa"},
+	} {
+		m, err := Parse(test.ip).MulticastMAC()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := m.String(); got != test.want {
+			t.Errorf("got %s, want %s", got, test.want)
+		}
+	}
+
+	_, err := Parse("192.0.2.1").MulticastMAC()
+	if !errors.Is(err, ErrNotMulticast) {
+		t.Errorf("got %v, want %v", err, ErrNotMulticast)
+	}
+}
+
+// TestMulticastGroups tests MulticastGroups
+func TestMulticastGroups(t *testing.T) {
+	m := mac.Parse("01:00:5e:01:02:03")
+	groups, err := MulticastGroups(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 32 {
+		t.Fatalf("got %d, want 32", len(groups))
+	}
+	for i, want := range map[int]string{
+		0:  "224.1.2.3",
+		1:  "224.129.2.3",
+		2:  "225.1.2.3",
+		31: "239.129.2.3",
+	} {
+		if got := groups[i].String(); got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+	for _, g := range groups {
+		gm, err := g.MulticastMAC()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gm.String() != m.String() {
+			t.Errorf("got %s, want %s", gm, m)
+		}
+	}
+
+	for _, s := range []string{"01:00:5e:81:02:03", "33:33:00:00:00:01"} {
+		_, err := MulticastGroups(mac.Parse(s))
+		if !errors.Is(err, ErrNotMulticast) {
+			t.Errorf("got %v, want %v", err, ErrNotMulticast)
+		}
+	}
+}
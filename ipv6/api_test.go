@@ -0,0 +1,66 @@
+package ipv6_test
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/netip"
+
+	"github.com/hwipl/random-addr/ipv6"
+	"github.com/hwipl/random-addr/mac"
+)
+
+// The following assignments make sure the exported API of the package does
+// not change by accident, they fail to compile if it does
+var (
+	_ fmt.Stringer = (*ipv6.IPv6)(nil)
+	_ error        = (*ipv6.ParseError)(nil)
+	_ error        = ipv6.ErrInvalidSyntax
+	_ error        = ipv6.ErrNotIPv6
+	_ error        = ipv6.ErrNotMulticast
+	_ error        = ipv6.ErrPrefixLength
+	_ error        = ipv6.ErrRandomSource
+
+	_ func(io.Reader) *ipv6.Generator      = ipv6.NewGenerator
+	_ func(rand.Source) *ipv6.Generator    = ipv6.NewSourceGenerator
+	_ func([]byte, string) *ipv6.Generator = ipv6.NewKeyedGenerator
+	_ func() (*ipv6.IPv6, error)           = ipv6.TryRandom
+	_ func() *ipv6.IPv6                    = ipv6.Random
+	_ func(string) (*ipv6.IPv6, error)     = ipv6.TryParse
+	_ func(string) *ipv6.IPv6              = ipv6.Parse
+
+	_ func(*ipv6.ParseError) string             = (*ipv6.ParseError).Error
+	_ func(*ipv6.ParseError) error              = (*ipv6.ParseError).Unwrap
+	_ func(*ipv6.IPv6) *mac.EUI64               = (*ipv6.IPv6).EUI64
+	_ func(*ipv6.IPv6) (*mac.MAC, error)        = (*ipv6.IPv6).EUI64MAC
+	_ func(*ipv6.Generator) (*ipv6.IPv6, error) = (*ipv6.Generator).Random
+	_ func(*ipv6.IPv6) netip.Addr               = (*ipv6.IPv6).Addr
+	_ func(*ipv6.IPv6) []byte                   = (*ipv6.IPv6).Bytes
+	_ func(*ipv6.IPv6) int                      = (*ipv6.IPv6).BitLen
+	_ func(*ipv6.IPv6) netip.Prefix             = (*ipv6.IPv6).Prefix
+	_ func(*ipv6.IPv6) string                   = (*ipv6.IPv6).Hex
+	_ func(*ipv6.IPv6) string                   = (*ipv6.IPv6).Binary
+	_ func(*ipv6.IPv6) string                   = (*ipv6.IPv6).Network
+	_ func(*ipv6.IPv6) string                   = (*ipv6.IPv6).Subnet
+	_ func(*ipv6.IPv6) string                   = (*ipv6.IPv6).IID
+	_ func(*ipv6.IPv6) bool                     = (*ipv6.IPv6).IPv4Mapped
+	_ func(*ipv6.IPv6) bool                     = (*ipv6.IPv6).GlobalUnicast
+	_ func(*ipv6.IPv6) bool                     = (*ipv6.IPv6).InterfaceLocalMulticast
+	_ func(*ipv6.IPv6) bool                     = (*ipv6.IPv6).LinkLocalMulticast
+	_ func(*ipv6.IPv6) bool                     = (*ipv6.IPv6).LinkLocalUnicast
+	_ func(*ipv6.IPv6) bool                     = (*ipv6.IPv6).Loopback
+	_ func(*ipv6.IPv6) bool                     = (*ipv6.IPv6).Multicast
+	_ func(*ipv6.IPv6) bool                     = (*ipv6.IPv6).Unicast
+	_ func(*ipv6.IPv6) bool                     = (*ipv6.IPv6).Private
+	_ func(*ipv6.IPv6) bool                     = (*ipv6.IPv6).Unspecified
+	_ func(*ipv6.IPv6) string                   = (*ipv6.IPv6).Type
+	_ func(*ipv6.IPv6) string                   = (*ipv6.IPv6).ExplainBin
+	_ func(*ipv6.IPv6) string                   = (*ipv6.IPv6).Table
+	_ func(*ipv6.IPv6) string                   = (*ipv6.IPv6).Explain
+	_ func(*ipv6.IPv6) string                   = (*ipv6.IPv6).String
+	_ func(*ipv6.IPv6, string) error            = (*ipv6.IPv6).TrySetPrefix
+	_ func(*ipv6.IPv6, string)                  = (*ipv6.IPv6).SetPrefix
+	_ func(*ipv6.IPv6, int) error               = (*ipv6.IPv6).TrySetPrefixLength
+	_ func(*ipv6.IPv6, int)                     = (*ipv6.IPv6).SetPrefixLength
+	_ func(*ipv6.IPv6) (*mac.MAC, error)        = (*ipv6.IPv6).MulticastMAC
+)
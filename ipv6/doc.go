@@ -0,0 +1,12 @@
+// Package ipv6 parses, generates and explains IPv6 addresses.
+//
+// Random addresses are created with [Random] or with a [Generator] that reads
+// random bytes from a custom source and can be placed inside a prefix with
+// [IPv6.SetPrefix]. Addresses are parsed with [Parse]. Functions and methods
+// prefixed with Try return an error instead of exiting the program if
+// something goes wrong, e.g., [TryRandom], [TryParse] and
+// [IPv6.TrySetPrefix].
+//
+// The exported API of this package is stable and follows semantic
+// versioning.
+package ipv6
@@ -0,0 +1,29 @@
+package ipv6
+
+import (
+	"fmt"
+
+	"github.com/hwipl/random-addr/mac"
+)
+
+// EUI64 returns the interface identifier of ip as modified EUI-64 converted
+// back to an EUI-64, i.e., with the U/L bit inverted
+func (ip *IPv6) EUI64() *mac.EUI64 {
+	return mac.EUI64FromBytes([8]byte(ip.b[8:])).ModifiedEUI64()
+}
+
+// EUI64MAC returns the MAC address the interface identifier of ip was
+// derived from with modified EUI-64 or an error if the interface identifier
+// is not derived from a MAC address. Unlike mac.EUI64.EUI48, it only accepts
+// FF:FE in the middle of the interface identifier as specified in RFC 4291
+func (ip *IPv6) EUI64MAC() (*mac.MAC, error) {
+	if ip.b[11] != 0xff || ip.b[12] != 0xfe {
+		return nil, fmt.Errorf("ipv6: %w: %s", mac.ErrNotEUI48,
+			ip.EUI64())
+	}
+	m, err := ip.EUI64().EUI48()
+	if err != nil {
+		return nil, fmt.Errorf("ipv6: %w", err)
+	}
+	return m, nil
+}
@@ -0,0 +1,36 @@
+package ipv6
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hwipl/random-addr/mac"
+)
+
+// TestEUI64MAC tests EUI64 and EUI64MAC
+func TestEUI64MAC(t *testing.T) {
+	ip := Parse("fe80::250:56ff:fe01:203")
+	want := "00:50:56:ff:fe:01:02:03"
+	if got := ip.EUI64().String(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	m, err := ip.EUI64MAC()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = "00:50:56:01:02:03"
+	if got := m.String(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	// FF:FF is only used for MAC-48 addresses in EUI-64s, not in IPv6
+	for _, s := range []string{
+		"2001:db8::1",
+		"fe80::250:56ff:ff01:203",
+	} {
+		_, err = Parse(s).EUI64MAC()
+		if !errors.Is(err, mac.ErrNotEUI48) {
+			t.Errorf("%s: got %v, want %v", s, err, mac.ErrNotEUI48)
+		}
+	}
+}
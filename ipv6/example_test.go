@@ -0,0 +1,36 @@
+package ipv6_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/hwipl/random-addr/ipv6"
+)
+
+func ExampleTryParse() {
+	ip, err := ipv6.TryParse("fe80::1/64")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(ip.Network())
+	fmt.Println(ip.Type())
+	// Output:
+	// fe80::
+	// link-local unicast
+}
+
+func ExampleIPv6_TrySetPrefix() {
+	g := ipv6.NewSourceGenerator(rand.NewPCG(1, 2))
+	ip, err := g.Random()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	if err := ip.TrySetPrefix("2001:db8::/48"); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(ip.Network())
+	// Output: 2001:db8::
+}
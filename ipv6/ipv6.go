@@ -1,16 +1,19 @@
 package ipv6
 
 import (
-	"crypto/rand"
 	"fmt"
 	"log"
 	"net/netip"
+	"strconv"
 	"strings"
 )
 
 const (
 	// bitsPerByte is the number of bits per byte
 	bitsPerByte = 8
+
+	// maxBits is the number of bits in an IPv6 address
+	maxBits = 128
 )
 
 // IPv6 is an IPv6 address
@@ -26,6 +29,17 @@ func (ip *IPv6) Addr() netip.Addr {
 	return netip.AddrFrom16(ip.b)
 }
 
+// Bytes returns ip as a byte slice
+func (ip *IPv6) Bytes() []byte {
+	b := ip.b
+	return b[:]
+}
+
+// BitLen returns the length of ip in bits
+func (ip *IPv6) BitLen() int {
+	return maxBits
+}
+
 // Prefix returns ip as Prefix
 func (ip *IPv6) Prefix() netip.Prefix {
 	return netip.PrefixFrom(ip.Addr(), ip.pl)
@@ -241,17 +255,43 @@ Type: %s
 	)
 }
 
+// Table returns all information about the IP as a table in a string
+func (ip *IPv6) Table() string {
+	return fmt.Sprintf(
+		` ------------------------------------------------------------
+| Hex     | %-48s |
+| Prefix  | %-48s |
+| Network | %-48s |
+| Subnet  | %-48s |
+| IID     | %-48s |
+| Type    | %-48s |
+ ------------------------------------------------------------`,
+		ip.Hex(),
+		ip.Prefix(),
+		ip.Network(),
+		ip.Subnet(),
+		ip.IID(),
+		ip.Type(),
+	)
+}
+
+// Explain returns an explanation of the IP and its structure as string,
+// it is ExplainBin and the MAC address of multicast groups
+func (ip *IPv6) Explain() string {
+	return ip.ExplainBin() + ip.explainMulticast()
+}
+
 // String returns ip as String
 func (ip *IPv6) String() string {
 	return ip.Hex()
 }
 
-// SetPrefix sets prefix in ip
-func (ip *IPv6) SetPrefix(prefix string) {
+// TrySetPrefix sets prefix in ip or returns an error if prefix is invalid
+func (ip *IPv6) TrySetPrefix(prefix string) error {
 	// parse prefix
-	p, err := netip.ParsePrefix(prefix)
+	p, err := parsePrefix(prefix)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
 	// get prefix bytes,
@@ -280,17 +320,43 @@ func (ip *IPv6) SetPrefix(prefix string) {
 
 	// set new prefix length
 	ip.pl = p.Bits()
+
+	return nil
+}
+
+// SetPrefix sets prefix in ip
+func (ip *IPv6) SetPrefix(prefix string) {
+	if err := ip.TrySetPrefix(prefix); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// TrySetPrefixLength sets prefix length of ip in number of bits or returns
+// an error if numBits is out of range
+func (ip *IPv6) TrySetPrefixLength(numBits int) error {
+	if numBits < 0 || numBits > maxBits {
+		return fmt.Errorf("ipv6: %w: %d", ErrPrefixLength, numBits)
+	}
+	ip.pl = numBits
+	return nil
 }
 
 // SetPrefixLength sets prefix length of ip in number of bits
 func (ip *IPv6) SetPrefixLength(numBits int) {
-	ip.pl = numBits
+	if err := ip.TrySetPrefixLength(numBits); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// TryRandom returns a random IPv6 address or an error if reading random
+// bytes fails
+func TryRandom() (*IPv6, error) {
+	return defaultGenerator.Random()
 }
 
 // Random returns a random IPv6 address
 func Random() *IPv6 {
-	ip := &IPv6{}
-	_, err := rand.Read(ip.b[:])
+	ip, err := TryRandom()
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -298,30 +364,75 @@ func Random() *IPv6 {
 	return ip
 }
 
-// Parse parses and returns the IPv6 address in s
-func Parse(s string) *IPv6 {
+// parseAddr parses the IPv6 address in s, input is the full string reported
+// in errors
+func parseAddr(input, s string) (netip.Addr, error) {
+	a, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}, &ParseError{Input: input, Err: ErrInvalidSyntax}
+	}
+	if !a.Is6() {
+		return netip.Addr{}, &ParseError{Input: input, Err: ErrNotIPv6}
+	}
+	return a, nil
+}
+
+// parsePrefix parses the IPv6 prefix in s
+func parsePrefix(s string) (netip.Prefix, error) {
+	p, err := netip.ParsePrefix(s)
+	if err == nil && p.Addr().Is6() {
+		return p, nil
+	}
+
+	// find out why parsing failed
+	addr, bits, ok := strings.Cut(s, "/")
+	if !ok {
+		return netip.Prefix{}, &ParseError{Input: s, Err: ErrInvalidSyntax}
+	}
+	if _, err := parseAddr(s, addr); err != nil {
+		return netip.Prefix{}, err
+	}
+	if n, err := strconv.Atoi(bits); err == nil && (n < 0 || n > maxBits) {
+		return netip.Prefix{}, &ParseError{Input: s, Err: ErrPrefixLength}
+	}
+	return netip.Prefix{}, &ParseError{Input: s, Err: ErrInvalidSyntax}
+}
+
+// TryParse parses and returns the IPv6 address in s or an error if s is not
+// a valid IPv6 address with optional prefix length
+func TryParse(s string) (*IPv6, error) {
 	ip := &IPv6{}
 
 	// parse ip with prefix
 	if strings.Contains(s, "/") {
-		p, err := netip.ParsePrefix(s)
+		p, err := parsePrefix(s)
 		if err != nil {
-			log.Fatal(err)
+			return nil, err
 		}
 
 		ip.b = p.Addr().As16()
 		ip.pl = p.Bits()
 
-		return ip
+		return ip, nil
 	}
 
 	// parse ip without prefix
-	a, err := netip.ParseAddr(s)
+	a, err := parseAddr(s, s)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	ip.b = a.As16()
 
+	return ip, nil
+}
+
+// Parse parses and returns the IPv6 address in s
+func Parse(s string) *IPv6 {
+	ip, err := TryParse(s)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	return ip
 }
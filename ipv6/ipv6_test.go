@@ -1,6 +1,10 @@
 package ipv6
 
-import "testing"
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
 
 // TestHex tests Hex of IPv6
 func TestHex(t *testing.T) {
@@ -122,3 +126,71 @@ func TestParse(t *testing.T) {
 		t.Errorf("got %s, want %s", got, want)
 	}
 }
+
+// TestTryParse tests parsing of IPv6 addresses with errors
+func TestTryParse(t *testing.T) {
+	// test valid addresses
+	for _, want := range []string{"fe80::1", "fe80::1/64"} {
+		ip, err := TryParse(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := ip.Prefix().String(); got != want && got != want+"/0" {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+
+	// test invalid addresses
+	for _, test := range []struct {
+		s    string
+		want error
+	}{
+		{"invalid", ErrInvalidSyntax},
+		{"fe80::1/x", ErrInvalidSyntax},
+		{"127.0.0.1", ErrNotIPv6},
+		{"127.0.0.1/8", ErrNotIPv6},
+		{"fe80::1/129", ErrPrefixLength},
+	} {
+		_, err := TryParse(test.s)
+		if !errors.Is(err, test.want) {
+			t.Errorf("%s: got %v, want %v", test.s, err, test.want)
+		}
+	}
+}
+
+// TestTrySetPrefix tests TrySetPrefix of IPv6 with errors
+func TestTrySetPrefix(t *testing.T) {
+	ip := &IPv6{}
+	if err := ip.TrySetPrefix("127.0.0.1/8"); !errors.Is(err, ErrNotIPv6) {
+		t.Errorf("got %v, want %v", err, ErrNotIPv6)
+	}
+	if err := ip.TrySetPrefix("fe80::1/129"); !errors.Is(err, ErrPrefixLength) {
+		t.Errorf("got %v, want %v", err, ErrPrefixLength)
+	}
+}
+
+// TestTrySetPrefixLength tests TrySetPrefixLength of IPv6 with errors
+func TestTrySetPrefixLength(t *testing.T) {
+	ip := &IPv6{}
+	for _, bits := range []int{-1, maxBits + 1} {
+		if err := ip.TrySetPrefixLength(bits); !errors.Is(err, ErrPrefixLength) {
+			t.Errorf("%d: got %v, want %v", bits, err, ErrPrefixLength)
+		}
+	}
+	if err := ip.TrySetPrefixLength(maxBits); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestBytes tests Bytes of IPv6
+func TestBytes(t *testing.T) {
+	ip := Parse("2001:db8::1")
+	want := []byte{0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}
+	got := ip.Bytes()
+	if !bytes.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if ip.BitLen() != len(want)*8 {
+		t.Errorf("got %d, want %d", ip.BitLen(), len(want)*8)
+	}
+}
@@ -0,0 +1,29 @@
+package ipv6
+
+import (
+	"fmt"
+
+	"github.com/hwipl/random-addr/mac"
+)
+
+// MulticastMAC returns the Ethernet MAC address of the multicast group ip,
+// it is 33:33 followed by the low 32 bits of ip
+func (ip *IPv6) MulticastMAC() (*mac.MAC, error) {
+	if !ip.Multicast() {
+		return nil, fmt.Errorf("ipv6: %w: %s", ErrNotMulticast, ip)
+	}
+	m := &mac.MAC{}
+	m.SetOUI([3]byte{0x33, 0x33, ip.b[12]})
+	m.SetNIC([3]byte{ip.b[13], ip.b[14], ip.b[15]})
+	return m, nil
+}
+
+// explainMulticast returns the Ethernet MAC address of a multicast ip as
+// string for Explain
+func (ip *IPv6) explainMulticast() string {
+	m, err := ip.MulticastMAC()
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("MAC:  %s\n", m)
+}
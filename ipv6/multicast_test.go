@@ -0,0 +1,31 @@
+package ipv6
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestMulticastMAC tests MulticastMAC
+func TestMulticastMAC(t *testing.T) {
+	for _, test := range []struct {
+		ip   string
+		want string
+	}{
+		{"ff02::1", "33:33:00:00:00:01"},
+		{"ff02::1:ff00:1234", "33:33:ff:00:12:34"},
+		{"ff05::1:3", "33:33:00:01:00:03"},
+	} {
+		m, err := Parse(test.ip).MulticastMAC()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := m.String(); got != test.want {
+			t.Errorf("got %s, want %s", got, test.want)
+		}
+	}
+
+	_, err := Parse("2001:db8::1").MulticastMAC()
+	if !errors.Is(err, ErrNotMulticast) {
+		t.Errorf("got %v, want %v", err, ErrNotMulticast)
+	}
+}
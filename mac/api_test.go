@@ -0,0 +1,226 @@
+package mac_test
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"math/rand/v2"
+	"regexp"
+
+	"github.com/hwipl/random-addr/mac"
+)
+
+// The following assignments make sure the exported API of the package does
+// not change by accident, they fail to compile if it does
+var (
+	_ fmt.Stringer = (*mac.MAC)(nil)
+	_ error        = (*mac.ParseError)(nil)
+	_ error        = mac.ErrInvalidCID
+	_ error        = mac.ErrInvalidLength
+	_ error        = mac.ErrInvalidRegistry
+	_ error        = mac.ErrInvalidSyntax
+	_ error        = mac.ErrNoAssignment
+	_ error        = mac.ErrNoFreeAddress
+	_ error        = mac.ErrNotEUI48
+	_ error        = mac.ErrPrefixLength
+	_ error        = mac.ErrRandomSource
+	_ error        = mac.ErrUnknownPreset
+
+	_ func(uint64) *mac.MAC                    = mac.FromUint64
+	_ func(string) (*mac.MAC, error)           = mac.TryParseBitReversed
+	_ func() (*mac.EUI64, error)               = mac.TryRandomEUI64
+	_ func() *mac.EUI64                        = mac.RandomEUI64
+	_ func([8]byte) *mac.EUI64                 = mac.EUI64FromBytes
+	_ func(string) (*mac.EUI64, error)         = mac.TryParseEUI64
+	_ func(string) *mac.EUI64                  = mac.ParseEUI64
+	_ func() []string                          = mac.Forms
+	_ func(string) (mac.Form, error)           = mac.LookupForm
+	_ func(string, mac.Form) (*mac.MAC, error) = mac.TryParseForm
+	_ func(string, mac.Form) *mac.MAC          = mac.ParseForm
+	_ func(io.Reader) *mac.Generator           = mac.NewGenerator
+	_ func(rand.Source) *mac.Generator         = mac.NewSourceGenerator
+	_ func([]byte, string) *mac.Generator      = mac.NewKeyedGenerator
+	_ func() (*mac.MAC, error)                 = mac.TryRandom
+	_ func() *mac.MAC                          = mac.Random
+	_ func() (*mac.MAC, error)                 = mac.TryRandomUI
+	_ func() *mac.MAC                          = mac.RandomUI
+	_ func() *mac.MAC                          = mac.RandomUU
+	_ func() (*mac.MAC, error)                 = mac.TryRandomUG
+	_ func() *mac.MAC                          = mac.RandomUG
+	_ func() *mac.MAC                          = mac.RandomUM
+	_ func() (*mac.MAC, error)                 = mac.TryRandomLI
+	_ func() *mac.MAC                          = mac.RandomLI
+	_ func() *mac.MAC                          = mac.RandomLU
+	_ func() (*mac.MAC, error)                 = mac.TryRandomLG
+	_ func() *mac.MAC                          = mac.RandomLG
+	_ func() *mac.MAC                          = mac.RandomLM
+	_ func(string) (*mac.MAC, error)           = mac.TryParse
+	_ func(string) *mac.MAC                    = mac.Parse
+	_ func(string) ([3]byte, error)            = mac.ParseOUI
+	_ func(string) ([3]byte, error)            = mac.ParseNIC
+	_ func() []string                          = mac.Notations
+	_ func(string) (mac.Notation, error)       = mac.ParseNotation
+	_ func() *mac.Registry                     = mac.NewRegistry
+	_ func() (*mac.Registry, error)            = mac.NewEmbeddedRegistry
+	_ func() string                            = mac.EmbeddedRegistryDate
+	_ func() *mac.Registry                     = mac.DefaultRegistry
+	_ func(*mac.Registry)                      = mac.SetDefaultRegistry
+	_ func(*mac.MAC, int) (*mac.Prefix, error) = mac.NewPrefix
+	_ func(string) (*mac.Prefix, error)        = mac.TryParsePrefix
+	_ func(string) *mac.Prefix                 = mac.ParsePrefix
+	_ func(*mac.Prefix) (*mac.MAC, error)      = mac.TryRandomPrefix
+	_ func(*mac.Prefix) *mac.MAC               = mac.RandomPrefix
+	_ func() []*mac.Preset                     = mac.Presets
+	_ func() []string                          = mac.PresetNames
+	_ func(string) (*mac.Preset, error)        = mac.LookupPreset
+	_ func(*mac.MAC) *mac.Preset               = mac.FindPreset
+	_ func(*mac.Preset) (*mac.MAC, error)      = mac.TryRandomPreset
+	_ func(*mac.Preset) *mac.MAC               = mac.RandomPreset
+	_ func(...*mac.MAC) *mac.Set               = mac.NewSet
+	_ func(string) (mac.Quadrant, error)       = mac.ParseQuadrant
+	_ func() (*mac.MAC, error)                 = mac.TryRandomAAI
+	_ func() *mac.MAC                          = mac.RandomAAI
+	_ func() (*mac.MAC, error)                 = mac.TryRandomSAI
+	_ func() *mac.MAC                          = mac.RandomSAI
+	_ func([3]byte) (*mac.MAC, error)          = mac.TryRandomELI
+	_ func([3]byte) *mac.MAC                   = mac.RandomELI
+	_ func() []*mac.WellKnown                  = mac.WellKnownRanges
+	_ func(*mac.MAC) *mac.WellKnown            = mac.LookupWellKnown
+	_ func(string) ([]*mac.WellKnown, error)   = mac.FindWellKnown
+
+	_ func(*mac.MAC) uint64                                           = (*mac.MAC).Uint64
+	_ func(*mac.MAC, int64) *mac.MAC                                  = (*mac.MAC).Add
+	_ func(*mac.MAC) *mac.MAC                                         = (*mac.MAC).Next
+	_ func(*mac.MAC) *mac.MAC                                         = (*mac.MAC).Prev
+	_ func(*mac.MAC, *mac.MAC) int                                    = (*mac.MAC).Compare
+	_ func(*mac.MAC) *mac.MAC                                         = (*mac.MAC).BitReversed
+	_ func(*mac.MAC) string                                           = (*mac.MAC).WireBinary
+	_ func(*mac.MAC) string                                           = (*mac.MAC).ExplainWire
+	_ func(*mac.ParseError) string                                    = (*mac.ParseError).Error
+	_ func(*mac.ParseError) error                                     = (*mac.ParseError).Unwrap
+	_ func(*mac.EUI64) string                                         = (*mac.EUI64).Hex
+	_ func(*mac.EUI64) string                                         = (*mac.EUI64).Binary
+	_ func(*mac.EUI64) string                                         = (*mac.EUI64).String
+	_ func(*mac.EUI64) []byte                                         = (*mac.EUI64).Bytes
+	_ func(*mac.EUI64) int                                            = (*mac.EUI64).BitLen
+	_ func(*mac.EUI64) bool                                           = (*mac.EUI64).Universal
+	_ func(*mac.EUI64) bool                                           = (*mac.EUI64).Local
+	_ func(*mac.EUI64) string                                         = (*mac.EUI64).UL
+	_ func(*mac.EUI64) bool                                           = (*mac.EUI64).Individual
+	_ func(*mac.EUI64) bool                                           = (*mac.EUI64).Group
+	_ func(*mac.EUI64) bool                                           = (*mac.EUI64).Unicast
+	_ func(*mac.EUI64) bool                                           = (*mac.EUI64).Multicast
+	_ func(*mac.EUI64) string                                         = (*mac.EUI64).IG
+	_ func(*mac.EUI64) string                                         = (*mac.EUI64).Type
+	_ func(*mac.EUI64) string                                         = (*mac.EUI64).OUI
+	_ func(*mac.EUI64) string                                         = (*mac.EUI64).Extension
+	_ func(*mac.EUI64) string                                         = (*mac.EUI64).Vendor
+	_ func(*mac.EUI64) string                                         = (*mac.EUI64).Explain
+	_ func(*mac.EUI64) string                                         = (*mac.EUI64).All
+	_ func(*mac.EUI64) string                                         = (*mac.EUI64).Table
+	_ func(*mac.EUI64)                                                = (*mac.EUI64).SetUniversal
+	_ func(*mac.EUI64)                                                = (*mac.EUI64).SetLocal
+	_ func(*mac.EUI64)                                                = (*mac.EUI64).SetIndividual
+	_ func(*mac.EUI64)                                                = (*mac.EUI64).SetGroup
+	_ func(*mac.EUI64) *mac.EUI64                                     = (*mac.EUI64).ModifiedEUI64
+	_ func(*mac.EUI64) (*mac.MAC, error)                              = (*mac.EUI64).EUI48
+	_ func(*mac.MAC) *mac.EUI64                                       = (*mac.MAC).EUI64
+	_ func(*mac.MAC) *mac.EUI64                                       = (*mac.MAC).ModifiedEUI64
+	_ func(*mac.Generator) (*mac.EUI64, error)                        = (*mac.Generator).RandomEUI64
+	_ func(*mac.MAC) string                                           = (*mac.MAC).Decimal
+	_ func(*mac.MAC) string                                           = (*mac.MAC).HexInt
+	_ func(*mac.MAC) string                                           = (*mac.MAC).Octal
+	_ func(*mac.MAC) string                                           = (*mac.MAC).Base64
+	_ func(*mac.MAC) string                                           = (*mac.MAC).ByteArray
+	_ func(*mac.MAC) string                                           = (*mac.MAC).EUI48
+	_ func(*mac.MAC, mac.Form) string                                 = (*mac.MAC).FormatForm
+	_ func(*mac.MAC, ...mac.Form) string                              = (*mac.MAC).AllWith
+	_ func(*mac.MAC, ...mac.Form) string                              = (*mac.MAC).TableWith
+	_ func(*mac.Generator) (*mac.MAC, error)                          = (*mac.Generator).Random
+	_ func(*mac.Generator) (*mac.MAC, error)                          = (*mac.Generator).RandomUI
+	_ func(*mac.Generator) (*mac.MAC, error)                          = (*mac.Generator).RandomUG
+	_ func(*mac.Generator) (*mac.MAC, error)                          = (*mac.Generator).RandomLI
+	_ func(*mac.Generator) (*mac.MAC, error)                          = (*mac.Generator).RandomLG
+	_ func(*mac.Generator, []*mac.Assignment) (*mac.MAC, error)       = (*mac.Generator).RandomAssignment
+	_ func(*mac.MAC) string                                           = (*mac.MAC).Hex
+	_ func(*mac.MAC) string                                           = (*mac.MAC).Binary
+	_ func(*mac.MAC) string                                           = (*mac.MAC).String
+	_ func(*mac.MAC) []byte                                           = (*mac.MAC).Bytes
+	_ func(*mac.MAC) int                                              = (*mac.MAC).BitLen
+	_ func(*mac.MAC) bool                                             = (*mac.MAC).Universal
+	_ func(*mac.MAC) bool                                             = (*mac.MAC).Local
+	_ func(*mac.MAC) string                                           = (*mac.MAC).UL
+	_ func(*mac.MAC) bool                                             = (*mac.MAC).Individual
+	_ func(*mac.MAC) bool                                             = (*mac.MAC).Group
+	_ func(*mac.MAC) bool                                             = (*mac.MAC).Unicast
+	_ func(*mac.MAC) bool                                             = (*mac.MAC).Multicast
+	_ func(*mac.MAC) string                                           = (*mac.MAC).IG
+	_ func(*mac.MAC) string                                           = (*mac.MAC).Type
+	_ func(*mac.MAC) string                                           = (*mac.MAC).OUI
+	_ func(*mac.MAC) string                                           = (*mac.MAC).NIC
+	_ func(*mac.MAC) string                                           = (*mac.MAC).Explain
+	_ func(*mac.MAC) string                                           = (*mac.MAC).ExplainHex
+	_ func(*mac.MAC) string                                           = (*mac.MAC).ExplainBin
+	_ func(*mac.MAC) string                                           = (*mac.MAC).All
+	_ func(*mac.MAC) string                                           = (*mac.MAC).Table
+	_ func(*mac.MAC)                                                  = (*mac.MAC).SetUniversal
+	_ func(*mac.MAC)                                                  = (*mac.MAC).SetLocal
+	_ func(*mac.MAC, bool)                                            = (*mac.MAC).SetUL
+	_ func(*mac.MAC)                                                  = (*mac.MAC).SetIndividual
+	_ func(*mac.MAC)                                                  = (*mac.MAC).SetGroup
+	_ func(*mac.MAC)                                                  = (*mac.MAC).SetUnicast
+	_ func(*mac.MAC)                                                  = (*mac.MAC).SetMulticast
+	_ func(*mac.MAC, bool)                                            = (*mac.MAC).SetIG
+	_ func(*mac.MAC, [3]byte)                                         = (*mac.MAC).SetOUI
+	_ func(*mac.MAC, [3]byte)                                         = (*mac.MAC).SetNIC
+	_ func(*mac.MAC, mac.Notation) string                             = (*mac.MAC).Format
+	_ func(*mac.Assignment) string                                    = (*mac.Assignment).Block
+	_ func(*mac.Assignment) string                                    = (*mac.Assignment).String
+	_ func(*mac.Assignment, *mac.MAC) bool                            = (*mac.Assignment).Contains
+	_ func(*mac.Assignment) *mac.Prefix                               = (*mac.Assignment).Range
+	_ func(*mac.Registry, *mac.Assignment)                            = (*mac.Registry).Add
+	_ func(*mac.Registry, *mac.MAC) *mac.Assignment                   = (*mac.Registry).Lookup
+	_ func(*mac.Registry) []*mac.Assignment                           = (*mac.Registry).Assignments
+	_ func(*mac.Registry, *regexp.Regexp) []*mac.Assignment           = (*mac.Registry).Find
+	_ func(*mac.Registry, string) ([]*mac.Assignment, error)          = (*mac.Registry).FindVendor
+	_ func(*mac.Registry) int                                         = (*mac.Registry).Len
+	_ func(*mac.Registry, io.Reader) error                            = (*mac.Registry).Load
+	_ func(*mac.Registry, string) error                               = (*mac.Registry).LoadFile
+	_ func(*mac.MAC) string                                           = (*mac.MAC).Vendor
+	_ func(*mac.Prefix) *mac.MAC                                      = (*mac.Prefix).Addr
+	_ func(*mac.Prefix) int                                           = (*mac.Prefix).Bits
+	_ func(*mac.Prefix) string                                        = (*mac.Prefix).String
+	_ func(*mac.Prefix) uint64                                        = (*mac.Prefix).Size
+	_ func(*mac.Prefix) *mac.MAC                                      = (*mac.Prefix).First
+	_ func(*mac.Prefix) *mac.MAC                                      = (*mac.Prefix).Last
+	_ func(*mac.Prefix, *mac.MAC) bool                                = (*mac.Prefix).Contains
+	_ func(*mac.Prefix, *mac.Prefix) uint64                           = (*mac.Prefix).Overlap
+	_ func(*mac.Prefix) iter.Seq[*mac.MAC]                            = (*mac.Prefix).All
+	_ func(*mac.MAC, *mac.Prefix)                                     = (*mac.MAC).SetPrefix
+	_ func(*mac.Generator, *mac.Prefix) (*mac.MAC, error)             = (*mac.Generator).RandomPrefix
+	_ func(*mac.Preset) string                                        = (*mac.Preset).String
+	_ func(*mac.MAC) string                                           = (*mac.MAC).Preset
+	_ func(*mac.Generator, *mac.Preset) (*mac.MAC, error)             = (*mac.Generator).RandomPreset
+	_ func(*mac.Set, ...*mac.MAC)                                     = (*mac.Set).Add
+	_ func(*mac.Set, *mac.MAC) bool                                   = (*mac.Set).Contains
+	_ func(*mac.Set) int                                              = (*mac.Set).Len
+	_ func(*mac.Set) iter.Seq[*mac.MAC]                               = (*mac.Set).All
+	_ func(*mac.Set, io.Reader) error                                 = (*mac.Set).Load
+	_ func(*mac.Set, string) error                                    = (*mac.Set).LoadFile
+	_ func(*mac.Set, func() (*mac.MAC, error)) (*mac.MAC, int, error) = (*mac.Set).Avoid
+	_ func(*mac.MAC) mac.Quadrant                                     = (*mac.MAC).Quadrant
+	_ func(*mac.MAC, mac.Quadrant)                                    = (*mac.MAC).SetQuadrant
+	_ func(*mac.MAC) string                                           = (*mac.MAC).CID
+	_ func(*mac.Generator, mac.Quadrant) (*mac.MAC, error)            = (*mac.Generator).RandomQuadrant
+	_ func(*mac.Generator) (*mac.MAC, error)                          = (*mac.Generator).RandomAAI
+	_ func(*mac.Generator) (*mac.MAC, error)                          = (*mac.Generator).RandomSAI
+	_ func(*mac.Generator, [3]byte) (*mac.MAC, error)                 = (*mac.Generator).RandomELI
+	_ func(*mac.WellKnown) string                                     = (*mac.WellKnown).Block
+	_ func(*mac.WellKnown) string                                     = (*mac.WellKnown).Description
+	_ func(*mac.WellKnown) string                                     = (*mac.WellKnown).String
+	_ func(*mac.WellKnown, *mac.MAC) bool                             = (*mac.WellKnown).Contains
+	_ func(*mac.WellKnown) *mac.Prefix                                = (*mac.WellKnown).Range
+	_ func(*mac.Prefix) uint64                                        = (*mac.Prefix).WellKnownSize
+	_ func(*mac.MAC) string                                           = (*mac.MAC).WellKnown
+	_ func(*mac.Generator, []*mac.WellKnown) (*mac.MAC, error)        = (*mac.Generator).RandomWellKnown
+)
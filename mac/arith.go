@@ -0,0 +1,44 @@
+package mac
+
+import "cmp"
+
+// maxUint48 is the largest MAC address as integer
+const maxUint48 = 1<<48 - 1
+
+// Uint64 returns the MAC as integer
+func (m *MAC) Uint64() uint64 {
+	return prefixKey(m.b, 48)
+}
+
+// FromUint64 returns the MAC address with the integer value v, only the low
+// 48 bits of v are used
+func FromUint64(v uint64) *MAC {
+	m := &MAC{}
+	for i := len(m.b) - 1; i >= 0; i-- {
+		m.b[i] = byte(v)
+		v >>= 8
+	}
+	return m
+}
+
+// Add returns the MAC address n addresses after the MAC, n can be negative,
+// the result wraps around at ff:ff:ff:ff:ff:ff and 00:00:00:00:00:00
+func (m *MAC) Add(n int64) *MAC {
+	return FromUint64((m.Uint64() + uint64(n)) & maxUint48)
+}
+
+// Next returns the MAC address after the MAC
+func (m *MAC) Next() *MAC {
+	return m.Add(1)
+}
+
+// Prev returns the MAC address before the MAC
+func (m *MAC) Prev() *MAC {
+	return m.Add(-1)
+}
+
+// Compare returns -1 if the MAC is less than o, 0 if it is equal to o and
+// +1 if it is greater than o
+func (m *MAC) Compare(o *MAC) int {
+	return cmp.Compare(m.Uint64(), o.Uint64())
+}
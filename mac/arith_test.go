@@ -0,0 +1,46 @@
+package mac
+
+import "testing"
+
+// TestUint64 tests Uint64 and FromUint64
+func TestUint64(t *testing.T) {
+	m := Parse("52:54:00:12:34:56")
+	var want uint64 = 0x525400123456
+	if got := m.Uint64(); got != want {
+		t.Errorf("got %x, want %x", got, want)
+	}
+	if got := FromUint64(want).String(); got != m.String() {
+		t.Errorf("got %s, want %s", got, m)
+	}
+	if got := FromUint64(0xffff525400123456).String(); got != m.String() {
+		t.Errorf("got %s, want %s", got, m)
+	}
+}
+
+// TestAdd tests Add, Next and Prev
+func TestAdd(t *testing.T) {
+	for _, test := range []struct {
+		got  *MAC
+		want string
+	}{
+		{Parse("52:54:00:12:34:56").Add(10), "52:54:00:12:34:60"},
+		{Parse("52:54:00:12:34:56").Add(-0x57), "52:54:00:12:33:ff"},
+		{Parse("52:54:00:12:34:ff").Next(), "52:54:00:12:35:00"},
+		{Parse("52:54:00:12:35:00").Prev(), "52:54:00:12:34:ff"},
+		{Parse("ff:ff:ff:ff:ff:ff").Next(), "00:00:00:00:00:00"},
+		{Parse("00:00:00:00:00:00").Prev(), "ff:ff:ff:ff:ff:ff"},
+	} {
+		if got := test.got.String(); got != test.want {
+			t.Errorf("got %s, want %s", got, test.want)
+		}
+	}
+}
+
+// TestCompare tests Compare
+func TestCompare(t *testing.T) {
+	a := Parse("52:54:00:12:34:56")
+	b := Parse("52:54:00:12:34:57")
+	if a.Compare(b) != -1 || b.Compare(a) != 1 || a.Compare(a) != 0 {
+		t.Errorf("wrong comparison of %s and %s", a, b)
+	}
+}
@@ -0,0 +1,54 @@
+package mac
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// BitReversed returns the MAC with the bits in each byte reversed, i.e., it
+// converts the canonical form to the bit-reversed (non-canonical) form used
+// by Token Ring and FDDI and vice versa
+func (m *MAC) BitReversed() *MAC {
+	r := &MAC{}
+	for i, b := range m.b {
+		r.b[i] = bits.Reverse8(b)
+	}
+	return r
+}
+
+// WireBinary returns the MAC as a binary string in transmission order on
+// Ethernet, i.e., each byte least significant bit first
+func (m *MAC) WireBinary() string {
+	return m.BitReversed().Binary()
+}
+
+// ExplainWire returns an explanation of the MAC in transmission order on
+// Ethernet as string, the I/G bit is the first bit sent
+func (m *MAC) ExplainWire() string {
+	hex := make([]string, len(m.b))
+	for i, b := range m.b {
+		hex[i] = fmt.Sprintf("%02x", b)
+	}
+	return fmt.Sprintf(`Transmission order, each byte is sent least significant bit first:
+Hex:     %s
+Wire: %s
+      ||
+      ||_ U/L: %s
+      |__ I/G: %s, first bit sent`,
+		strings.Join(hex, "   :   "),
+		m.WireBinary(),
+		m.UL(), m.IG(),
+	)
+}
+
+// TryParseBitReversed parses the MAC address in bit-reversed (non-canonical)
+// form in s and returns it in canonical form or an error if s is not a
+// valid MAC address
+func TryParseBitReversed(s string) (*MAC, error) {
+	m, err := TryParse(s)
+	if err != nil {
+		return nil, err
+	}
+	return m.BitReversed(), nil
+}
@@ -0,0 +1,47 @@
+package mac
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestBitReversed tests BitReversed
+func TestBitReversed(t *testing.T) {
+	m := Parse("01:80:c2:00:00:0e")
+	want := "80:01:43:00:00:70"
+	r := m.BitReversed()
+	if got := r.String(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	// test back to canonical
+	if got := r.BitReversed().String(); got != m.String() {
+		t.Errorf("got %s, want %s", got, m)
+	}
+
+	p, err := TryParseBitReversed(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.String() != m.String() {
+		t.Errorf("got %s, want %s", p, m)
+	}
+}
+
+// TestExplainWire tests WireBinary and ExplainWire
+func TestExplainWire(t *testing.T) {
+	m := Parse("01:00:5e:00:00:fb")
+	want := "10000000:00000000:01111010:00000000:00000000:11011111"
+	if got := m.WireBinary(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	want = `Hex:     01   :   00   :   5e   :   00   :   00   :   fb
+Wire: 10000000:00000000:01111010:00000000:00000000:11011111
+      ||
+      ||_ U/L: Universal
+      |__ I/G: Group (Multicast), first bit sent`
+	if got := m.ExplainWire(); !strings.HasSuffix(got, want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
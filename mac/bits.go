@@ -0,0 +1,112 @@
+package mac
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// bits in the first byte of MAC and EUI-64 addresses
+const (
+	// bitIG is the Individual/Group (I/G) bit
+	bitIG = 0b00000001
+
+	// bitUL is the Universal/Local (U/L) bit
+	bitUL = 0b00000010
+)
+
+// hexBytes returns b as hex string with bytes separated by colons
+func hexBytes(b []byte) string {
+	s := make([]string, len(b))
+	for i := range b {
+		s[i] = fmt.Sprintf("%02x", b[i])
+	}
+	return strings.Join(s, ":")
+}
+
+// binBytes returns b as binary string with bytes separated by colons
+func binBytes(b []byte) string {
+	s := make([]string, len(b))
+	for i := range b {
+		s[i] = fmt.Sprintf("%08b", b[i])
+	}
+	return strings.Join(s, ":")
+}
+
+// universal returns whether the U/L bit of the address in b is 0
+func universal(b []byte) bool {
+	return b[0]&bitUL == 0
+}
+
+// individual returns whether the I/G bit of the address in b is 0
+func individual(b []byte) bool {
+	return b[0]&bitIG == 0
+}
+
+// ulString returns the U/L bit of the address in b as string
+func ulString(b []byte) string {
+	if !universal(b) {
+		return "Local"
+	}
+	return "Universal"
+}
+
+// igString returns the I/G bit of the address in b as string
+func igString(b []byte) string {
+	if !individual(b) {
+		return "Group (Multicast)"
+	}
+	return "Individual (Unicast)"
+}
+
+// typeString returns the type of the address in b, e.g.,
+// "universal unicast"
+func typeString(b []byte) string {
+	ul := "universal"
+	if !universal(b) {
+		ul = "local"
+	}
+	ig := "unicast"
+	if !individual(b) {
+		ig = "multicast"
+	}
+	return fmt.Sprintf("%s %s", ul, ig)
+}
+
+// setUL sets the U/L bit of the address in b to 0 if universal, otherwise
+// to 1
+func setUL(b []byte, universal bool) {
+	if universal {
+		b[0] &^= bitUL
+		return
+	}
+	b[0] |= bitUL
+}
+
+// setIG sets the I/G bit of the address in b to 0 if individual, otherwise
+// to 1
+func setIG(b []byte, individual bool) {
+	if individual {
+		b[0] &^= bitIG
+		return
+	}
+	b[0] |= bitIG
+}
+
+// parseBytes parses the address in s into b, s must contain exactly
+// len(b) bytes in any Notation
+func parseBytes(s string, b []byte) error {
+	if c, ok := parseCompact(s, len(b)); ok {
+		copy(b, c)
+		return nil
+	}
+	hw, err := net.ParseMAC(s)
+	if err != nil {
+		return &ParseError{Input: s, Err: ErrInvalidSyntax}
+	}
+	if len(hw) != len(b) {
+		return &ParseError{Input: s, Err: ErrInvalidLength}
+	}
+	copy(b, hw)
+	return nil
+}
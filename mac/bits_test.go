@@ -0,0 +1,63 @@
+package mac
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestBits tests the bit helpers with MAC and EUI-64 lengths
+func TestBits(t *testing.T) {
+	for _, b := range [][]byte{make([]byte, 6), make([]byte, 8)} {
+		if !universal(b) || !individual(b) {
+			t.Errorf("%x: not universal individual", b)
+		}
+		if got := typeString(b); got != "universal unicast" {
+			t.Errorf("%x: got %s, want universal unicast", b, got)
+		}
+
+		setUL(b, false)
+		setIG(b, false)
+		if b[0] != bitUL|bitIG {
+			t.Errorf("got %x, want %x", b[0], bitUL|bitIG)
+		}
+		if ulString(b) != "Local" || igString(b) != "Group (Multicast)" {
+			t.Errorf("%x: got %s %s, want Local Group (Multicast)", b,
+				ulString(b), igString(b))
+		}
+		if got := typeString(b); got != "local multicast" {
+			t.Errorf("%x: got %s, want local multicast", b, got)
+		}
+
+		setUL(b, true)
+		setIG(b, true)
+		if b[0] != 0 {
+			t.Errorf("got %x, want 0", b[0])
+		}
+	}
+}
+
+// TestParseBytes tests parseBytes with MAC and EUI-64 lengths
+func TestParseBytes(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		n    int
+		want string
+		err  error
+	}{
+		{"00:11:22:33:44:55", 6, "00:11:22:33:44:55", nil},
+		{"001122334455", 6, "00:11:22:33:44:55", nil},
+		{"00-11-22-33-44-55-66-77", 8, "00:11:22:33:44:55:66:77", nil},
+		{"00:11:22:33:44:55", 8, "", ErrInvalidLength},
+		{"zz:11:22:33:44:55", 6, "", ErrInvalidSyntax},
+	} {
+		b := make([]byte, test.n)
+		err := parseBytes(test.s, b)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: got %v, want %v", test.s, err, test.err)
+			continue
+		}
+		if err == nil && hexBytes(b) != test.want {
+			t.Errorf("%s: got %s, want %s", test.s, hexBytes(b), test.want)
+		}
+	}
+}
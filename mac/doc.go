@@ -0,0 +1,11 @@
+// Package mac parses, generates and explains MAC addresses.
+//
+// Random addresses are created with [Random] and its variants, or with a
+// [Generator] that reads random bytes from a custom source. Addresses are
+// parsed with [Parse]. Functions prefixed with Try return an error instead
+// of exiting the program if something goes wrong, e.g., [TryRandom] and
+// [TryParse].
+//
+// The exported API of this package is stable and follows semantic
+// versioning.
+package mac
@@ -0,0 +1,59 @@
+package mac
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidSyntax is returned if an address string is malformed
+	ErrInvalidSyntax = errors.New("invalid syntax")
+
+	// ErrInvalidLength is returned if an address has the wrong length
+	ErrInvalidLength = errors.New("invalid address length")
+
+	// ErrRandomSource is returned if reading random bytes fails
+	ErrRandomSource = errors.New("cannot read random bytes")
+
+	// ErrPrefixLength is returned if a prefix length is out of range
+	ErrPrefixLength = errors.New("prefix length out of range")
+
+	// ErrNoFreeAddress is returned if no generated address is outside of
+	// a set of known addresses
+	ErrNoFreeAddress = errors.New("no free address found")
+
+	// ErrNoAssignment is returned if there is no assignment to generate an
+	// address in
+	ErrNoAssignment = errors.New("no matching assignment")
+
+	// ErrInvalidRegistry is returned if a registry file is malformed
+	ErrInvalidRegistry = errors.New("invalid registry file")
+
+	// ErrInvalidCID is returned if a Company ID is not in the ELI quadrant
+	ErrInvalidCID = errors.New("invalid CID")
+
+	// ErrUnknownPreset is returned if a preset does not exist
+	ErrUnknownPreset = errors.New("unknown preset")
+
+	// ErrNotEUI48 is returned if an EUI-64 was not created from an EUI-48
+	ErrNotEUI48 = errors.New("EUI-64 not derived from EUI-48")
+)
+
+// ParseError is returned if parsing an address fails
+type ParseError struct {
+	// Input is the string that could not be parsed
+	Input string
+
+	// Err is the reason parsing failed
+	Err error
+}
+
+// Error returns e as string
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("mac: cannot parse %q: %v", e.Input, e.Err)
+}
+
+// Unwrap returns the reason parsing failed
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,271 @@
+package mac
+
+import (
+	"fmt"
+	"io"
+)
+
+// EUI64 is an EUI-64 address
+type EUI64 struct {
+	b [8]byte
+}
+
+// Hex returns EUI64 as hex string
+func (e *EUI64) Hex() string {
+	return hexBytes(e.b[:])
+}
+
+// Binary returns EUI64 as a binary string
+func (e *EUI64) Binary() string {
+	return binBytes(e.b[:])
+}
+
+// String returns EUI64 as a string
+func (e *EUI64) String() string {
+	return e.Hex()
+}
+
+// Bytes returns EUI64 as a byte slice
+func (e *EUI64) Bytes() []byte {
+	b := e.b
+	return b[:]
+}
+
+// BitLen returns the length of EUI64 in bits
+func (e *EUI64) BitLen() int {
+	return len(e.b) * 8
+}
+
+// Universal returns true if EUI64 is globally unique,
+// i.e. Universal/Local (U/L) bit is 0
+func (e *EUI64) Universal() bool {
+	return universal(e.b[:])
+}
+
+// Local returns true if EUI64 is locally administered,
+// i.e. Universal/Local (U/L) bit is 1
+func (e *EUI64) Local() bool {
+	return !e.Universal()
+}
+
+// UL returns the U/L bit as string
+func (e *EUI64) UL() string {
+	return ulString(e.b[:])
+}
+
+// Individual returns true if EUI64's Individual/Group (I/G) bit is 0
+func (e *EUI64) Individual() bool {
+	return individual(e.b[:])
+}
+
+// Group returns true if EUI64's Individual/Group (I/G) bit is 1
+func (e *EUI64) Group() bool {
+	return !e.Individual()
+}
+
+// Unicast returns true if EUI64 is unicast,
+// i.e. Individual/Group (I/G) bit is 0
+func (e *EUI64) Unicast() bool {
+	return e.Individual()
+}
+
+// Multicast returns true if EUI64 is multicast,
+// i.e. Individual/Group (I/G) bit is 1
+func (e *EUI64) Multicast() bool {
+	return e.Group()
+}
+
+// IG returns the I/G (Unicast/Multicast) bit as a string
+func (e *EUI64) IG() string {
+	return igString(e.b[:])
+}
+
+// Type returns the type of EUI64
+func (e *EUI64) Type() string {
+	return typeString(e.b[:])
+}
+
+// OUI returns the OUI part of EUI64 as string
+func (e *EUI64) OUI() string {
+	return hexBytes(e.b[:3])
+}
+
+// Extension returns the extension identifier part of EUI64 as string
+func (e *EUI64) Extension() string {
+	return hexBytes(e.b[3:])
+}
+
+// Vendor returns the organization the EUI64 is assigned to in the default
+// registry or an empty string if it is unknown
+func (e *EUI64) Vendor() string {
+	m := &MAC{}
+	copy(m.b[:], e.b[:])
+	return m.Vendor()
+}
+
+// Explain returns an explanation of the EUI64 and its structure as string
+func (e *EUI64) Explain() string {
+	return fmt.Sprintf(`           OUI: %s                 Extension identifier: %s
+      ___________/\___________   ____________________/\____________________
+     |                        | |                                            |
+Hex:    %02x   :   %02x   :   %02x   :   %02x   :   %02x   :   %02x   :   %02x   :   %02x
+Bin: %s
+           ||
+           ||_ I/G: %s
+           |__ U/L: %s`,
+		e.OUI(), e.Extension(),
+		e.b[0], e.b[1], e.b[2], e.b[3], e.b[4], e.b[5], e.b[6], e.b[7],
+		e.Binary(),
+		e.IG(), e.UL(),
+	)
+}
+
+// vendorString returns the vendor of the EUI64 for display, shortened to
+// max runes if max is not 0
+func (e *EUI64) vendorString(max int) string {
+	if v := e.Vendor(); v != "" {
+		return truncate(v, max)
+	}
+	return "Unknown"
+}
+
+// All returns all information about the EUI64 as string
+func (e *EUI64) All() string {
+	return fmt.Sprintf(`Hex:          %s
+OUI:          %s
+Extension:    %s
+Vendor:       %s
+Binary:       %s
+U/L:          %s
+I/G:          %s`,
+		e.Hex(),
+		e.OUI(),
+		e.Extension(),
+		e.vendorString(0),
+		e.Binary(),
+		e.UL(),
+		e.IG(),
+	)
+}
+
+// Table returns all information about the EUI64 as a table in a string
+func (e *EUI64) Table() string {
+	return fmt.Sprintf(
+		` ----------------------------------------------------------------------------------------
+| Hex          | %-71s |
+| OUI          | %-71s |
+| Extension    | %-71s |
+| Vendor       | %-71s |
+| Binary       | %-71s |
+| U/L          | %-71s |
+| I/G          | %-71s |
+ ----------------------------------------------------------------------------------------`,
+		e.Hex(),
+		e.OUI(),
+		e.Extension(),
+		e.vendorString(71),
+		e.Binary(),
+		e.UL(),
+		e.IG(),
+	)
+}
+
+// SetUniversal sets the U/L bit of the EUI64 to 0 (universal)
+func (e *EUI64) SetUniversal() {
+	setUL(e.b[:], true)
+}
+
+// SetLocal sets the U/L bit of the EUI64 to 1 (local)
+func (e *EUI64) SetLocal() {
+	setUL(e.b[:], false)
+}
+
+// SetIndividual sets the I/G bit of the EUI64 to 0 (individual)
+func (e *EUI64) SetIndividual() {
+	setIG(e.b[:], true)
+}
+
+// SetGroup sets the I/G bit of the EUI64 to 1 (group)
+func (e *EUI64) SetGroup() {
+	setIG(e.b[:], false)
+}
+
+// ModifiedEUI64 returns the modified EUI-64 of the EUI64 as used in IPv6
+// interface identifiers, i.e., a copy with the U/L bit inverted. Calling it
+// on a modified EUI-64 returns the original EUI-64
+func (e *EUI64) ModifiedEUI64() *EUI64 {
+	m := &EUI64{b: e.b}
+	m.b[0] ^= bitUL
+	return m
+}
+
+// EUI48 returns the EUI-48 (MAC) the EUI64 was created from by inserting
+// FF:FE or FF:FF in the middle, otherwise it returns an error. FF:FF is only
+// used for MAC-48 addresses encapsulated in EUI-64s, IPv6 interface
+// identifiers always use FF:FE
+func (e *EUI64) EUI48() (*MAC, error) {
+	if e.b[3] != 0xff || (e.b[4] != 0xfe && e.b[4] != 0xff) {
+		return nil, fmt.Errorf("mac: %w: %s", ErrNotEUI48, e)
+	}
+	m := &MAC{}
+	copy(m.b[:3], e.b[:3])
+	copy(m.b[3:], e.b[5:])
+	return m, nil
+}
+
+// EUI64 returns the EUI-64 created from the MAC by inserting FF:FE between
+// the OUI and NIC specific parts
+func (m *MAC) EUI64() *EUI64 {
+	e := &EUI64{}
+	copy(e.b[:3], m.b[:3])
+	e.b[3] = 0xff
+	e.b[4] = 0xfe
+	copy(e.b[5:], m.b[3:])
+	return e
+}
+
+// ModifiedEUI64 returns the modified EUI-64 created from the MAC as used in
+// IPv6 interface identifiers
+func (m *MAC) ModifiedEUI64() *EUI64 {
+	return m.EUI64().ModifiedEUI64()
+}
+
+// RandomEUI64 returns a random EUI-64 address
+func (g *Generator) RandomEUI64() (*EUI64, error) {
+	e := &EUI64{}
+	_, err := io.ReadFull(g.r, e.b[:])
+	if err != nil {
+		return nil, fmt.Errorf("mac: %w: %w", ErrRandomSource, err)
+	}
+	return e, nil
+}
+
+// TryRandomEUI64 returns a random EUI-64 address or an error
+func TryRandomEUI64() (*EUI64, error) {
+	return defaultGenerator.RandomEUI64()
+}
+
+// RandomEUI64 returns a random EUI-64 address
+func RandomEUI64() *EUI64 {
+	return must(TryRandomEUI64)
+}
+
+// EUI64FromBytes returns the EUI-64 address in b
+func EUI64FromBytes(b [8]byte) *EUI64 {
+	return &EUI64{b: b}
+}
+
+// TryParseEUI64 parses and returns the EUI-64 address in s or an error if s
+// is not a valid EUI-64 address
+func TryParseEUI64(s string) (*EUI64, error) {
+	e := &EUI64{}
+	if err := parseBytes(s, e.b[:]); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// ParseEUI64 parses and returns the EUI-64 address in s
+func ParseEUI64(s string) *EUI64 {
+	return must(func() (*EUI64, error) { return TryParseEUI64(s) })
+}
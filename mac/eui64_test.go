@@ -0,0 +1,90 @@
+package mac
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestEUI64 tests EUI64 of MAC
+func TestEUI64(t *testing.T) {
+	m := Parse("00:50:56:01:02:03")
+	want := "00:50:56:ff:fe:01:02:03"
+	got := m.EUI64().String()
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+// TestModifiedEUI64 tests ModifiedEUI64 of MAC and EUI64
+func TestModifiedEUI64(t *testing.T) {
+	m := Parse("00:50:56:01:02:03")
+	e := m.ModifiedEUI64()
+	want := "02:50:56:ff:fe:01:02:03"
+	if got := e.String(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if !e.Local() {
+		t.Errorf("modified EUI-64 is not local")
+	}
+
+	// test back to original
+	want = "00:50:56:ff:fe:01:02:03"
+	if got := e.ModifiedEUI64().String(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+// TestEUI48 tests EUI48 of EUI64
+func TestEUI48(t *testing.T) {
+	want := "00:50:56:01:02:03"
+	for _, s := range []string{
+		"00:50:56:ff:fe:01:02:03",
+		"00:50:56:ff:ff:01:02:03",
+	} {
+		m, err := ParseEUI64(s).EUI48()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := m.String(); got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+
+	_, err := ParseEUI64("00:50:56:01:02:03:04:05").EUI48()
+	if !errors.Is(err, ErrNotEUI48) {
+		t.Errorf("got %v, want %v", err, ErrNotEUI48)
+	}
+}
+
+// TestTryParseEUI64 tests TryParseEUI64
+func TestTryParseEUI64(t *testing.T) {
+	want := "00:50:56:ff:fe:01:02:03"
+	e, err := TryParseEUI64("00-50-56-FF-FE-01-02-03")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := e.String(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	if _, err := TryParseEUI64("00:50:56:01:02:03"); !errors.Is(err,
+		ErrInvalidLength) {
+		t.Errorf("got %v, want %v", err, ErrInvalidLength)
+	}
+}
+
+// TestEUI64FromBytes tests EUI64FromBytes
+func TestEUI64FromBytes(t *testing.T) {
+	b := [8]byte{0x00, 0x50, 0x56, 0xff, 0xfe, 0x01, 0x02, 0x03}
+	e := EUI64FromBytes(b)
+	want := "00:50:56:ff:fe:01:02:03"
+	if got := e.String(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	// e does not share memory with b
+	b[0] = 0xff
+	if got := e.String(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
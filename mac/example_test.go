@@ -0,0 +1,43 @@
+package mac_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/hwipl/random-addr/mac"
+)
+
+func ExampleTryParse() {
+	m, err := mac.TryParse("52:54:00:12:34:56")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(m.OUI())
+	fmt.Println(m.UL())
+	fmt.Println(m.IG())
+	// Output:
+	// 52:54:00
+	// Local
+	// Individual (Unicast)
+}
+
+func ExampleGenerator_RandomLI() {
+	g := mac.NewSourceGenerator(rand.NewPCG(1, 2))
+	m, err := g.RandomLI()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(m.Local(), m.Unicast())
+	// Output: true true
+}
+
+func ExampleMAC_Format() {
+	m := mac.Parse("00:11:22:aa:bb:cc")
+	fmt.Println(m.Format(mac.NotationCisco))
+	fmt.Println(m.Format(mac.NotationHyphen | mac.NotationUpper))
+	// Output:
+	// 0011.22aa.bbcc
+	// 00-11-22-AA-BB-CC
+}
@@ -0,0 +1,255 @@
+package mac
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Form is an alternative representation of MAC addresses, e.g., as integer
+type Form int
+
+// forms
+const (
+	// FormDecimal is the 48 bit unsigned integer in decimal, e.g.,
+	// 73588229205
+	FormDecimal Form = iota
+
+	// FormHexInt is the 48 bit unsigned integer in hex, e.g.,
+	// 0x001122334455
+	FormHexInt
+
+	// FormOctal is the 48 bit unsigned integer in octal, e.g.,
+	// 0o1044214642125
+	FormOctal
+
+	// FormBase64 is the standard base64 encoding of the 6 bytes, e.g.,
+	// ABEiM0RV
+	FormBase64
+
+	// FormByteArray is a byte array literal as used in C and Go, e.g.,
+	// {0x00, 0x11, 0x22, 0x33, 0x44, 0x55}
+	FormByteArray
+
+	// FormEUI48 is the form used in the IEEE registry, e.g.,
+	// 00-11-22-33-44-55 in upper case
+	FormEUI48
+)
+
+// formNames are the names of the forms
+var formNames = []string{
+	FormDecimal:   "decimal",
+	FormHexInt:    "hex-int",
+	FormOctal:     "octal",
+	FormBase64:    "base64",
+	FormByteArray: "byte-array",
+	FormEUI48:     "eui48",
+}
+
+// formTitles are the titles of the forms in All and Table
+var formTitles = []string{
+	FormDecimal:   "Decimal",
+	FormHexInt:    "Hex integer",
+	FormOctal:     "Octal",
+	FormBase64:    "Base64",
+	FormByteArray: "Byte array",
+	FormEUI48:     "EUI-48",
+}
+
+// Forms returns the names of all forms accepted by LookupForm
+func Forms() []string {
+	return append([]string(nil), formNames...)
+}
+
+// String returns f as string
+func (f Form) String() string {
+	if f < 0 || int(f) >= len(formNames) {
+		return "unknown"
+	}
+	return formNames[f]
+}
+
+// title returns the title of f in All and Table
+func (f Form) title() string {
+	if f < 0 || int(f) >= len(formTitles) {
+		return "Unknown"
+	}
+	return formTitles[f]
+}
+
+// LookupForm returns the form with the name in s, e.g., "decimal"
+func LookupForm(s string) (Form, error) {
+	name := strings.ToLower(s)
+	for i, n := range formNames {
+		if n == name {
+			return Form(i), nil
+		}
+	}
+	return FormDecimal, &ParseError{Input: s, Err: ErrInvalidSyntax}
+}
+
+// Decimal returns the MAC as unsigned integer in decimal
+func (m *MAC) Decimal() string {
+	return strconv.FormatUint(m.Uint64(), 10)
+}
+
+// HexInt returns the MAC as unsigned integer in hex with 0x prefix
+func (m *MAC) HexInt() string {
+	return fmt.Sprintf("0x%012x", m.Uint64())
+}
+
+// Octal returns the MAC as unsigned integer in octal with 0o prefix
+func (m *MAC) Octal() string {
+	return "0o" + strconv.FormatUint(m.Uint64(), 8)
+}
+
+// Base64 returns the MAC bytes in standard base64 encoding
+func (m *MAC) Base64() string {
+	return base64.StdEncoding.EncodeToString(m.b[:])
+}
+
+// ByteArray returns the MAC as byte array literal
+func (m *MAC) ByteArray() string {
+	bytes := make([]string, len(m.b))
+	for i, b := range m.b {
+		bytes[i] = fmt.Sprintf("0x%02x", b)
+	}
+	return "{" + strings.Join(bytes, ", ") + "}"
+}
+
+// EUI48 returns the MAC in the form used in the IEEE registry, i.e., hyphen
+// separated in upper case
+func (m *MAC) EUI48() string {
+	return m.Format(NotationHyphen | NotationUpper)
+}
+
+// FormatForm returns the MAC as string in form f
+func (m *MAC) FormatForm(f Form) string {
+	switch f {
+	case FormDecimal:
+		return m.Decimal()
+	case FormHexInt:
+		return m.HexInt()
+	case FormOctal:
+		return m.Octal()
+	case FormBase64:
+		return m.Base64()
+	case FormByteArray:
+		return m.ByteArray()
+	case FormEUI48:
+		return m.EUI48()
+	}
+	return m.String()
+}
+
+// AllWith returns all information about the MAC as string like All with
+// additional lines for the forms in forms
+func (m *MAC) AllWith(forms ...Form) string {
+	var b strings.Builder
+	b.WriteString(m.All())
+	for _, f := range forms {
+		fmt.Fprintf(&b, "\n%-14s%s", f.title()+":", m.FormatForm(f))
+	}
+	return b.String()
+}
+
+// TableWith returns all information about the MAC as a table in a string
+// like Table with additional rows for the forms in forms
+func (m *MAC) TableWith(forms ...Form) string {
+	t := m.Table()
+	i := strings.LastIndex(t, "\n")
+	var b strings.Builder
+	b.WriteString(t[:i])
+	for _, f := range forms {
+		fmt.Fprintf(&b, "\n| %-12s | %-53s |", f.title(), m.FormatForm(f))
+	}
+	b.WriteString(t[i:])
+	return b.String()
+}
+
+// parseUint48 parses the unsigned integer in s with base and returns it as
+// MAC address
+func parseUint48(s string, base int) (*MAC, error) {
+	v, err := strconv.ParseUint(s, base, 64)
+	if err != nil {
+		return nil, ErrInvalidSyntax
+	}
+	if v >= 1<<48 {
+		return nil, ErrInvalidLength
+	}
+	return FromUint64(v), nil
+}
+
+// parseByteArray parses the byte array literal in s, the braces or
+// brackets are optional
+func parseByteArray(s string) (*MAC, error) {
+	s = strings.Trim(s, "{}[] ")
+	bytes := strings.Split(s, ",")
+	if strings.TrimSpace(bytes[len(bytes)-1]) == "" {
+		// trailing comma
+		bytes = bytes[:len(bytes)-1]
+	}
+	m := &MAC{}
+	if len(bytes) != len(m.b) {
+		return nil, ErrInvalidLength
+	}
+	for i, b := range bytes {
+		v, err := strconv.ParseUint(strings.TrimSpace(b), 0, 8)
+		if err != nil {
+			return nil, ErrInvalidSyntax
+		}
+		m.b[i] = byte(v)
+	}
+	return m, nil
+}
+
+// parseForm parses the MAC address in form f in s
+func parseForm(s string, f Form) (*MAC, error) {
+	switch f {
+	case FormDecimal:
+		return parseUint48(s, 10)
+	case FormHexInt:
+		if h, ok := strings.CutPrefix(strings.ToLower(s), "0x"); ok {
+			s = h
+		}
+		return parseUint48(s, 16)
+	case FormOctal:
+		if o, ok := strings.CutPrefix(strings.ToLower(s), "0o"); ok {
+			s = o
+		}
+		return parseUint48(s, 8)
+	case FormBase64:
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, ErrInvalidSyntax
+		}
+		m := &MAC{}
+		if len(b) != len(m.b) {
+			return nil, ErrInvalidLength
+		}
+		copy(m.b[:], b)
+		return m, nil
+	case FormByteArray:
+		return parseByteArray(s)
+	}
+	return nil, ErrInvalidSyntax
+}
+
+// TryParseForm parses the MAC address in form f in s and returns it or an
+// error if s is not a valid MAC address in form f
+func TryParseForm(s string, f Form) (*MAC, error) {
+	if f == FormEUI48 {
+		return TryParse(s)
+	}
+	m, err := parseForm(strings.TrimSpace(s), f)
+	if err != nil {
+		return nil, &ParseError{Input: s, Err: err}
+	}
+	return m, nil
+}
+
+// ParseForm parses and returns the MAC address in form f in s
+func ParseForm(s string, f Form) *MAC {
+	return must(func() (*MAC, error) { return TryParseForm(s, f) })
+}
@@ -0,0 +1,96 @@
+package mac
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestFormatForm tests FormatForm and TryParseForm of all forms
+func TestFormatForm(t *testing.T) {
+	m := Parse("00:11:22:aa:bb:cc")
+	for _, test := range []struct {
+		f    Form
+		want string
+	}{
+		{FormDecimal, "73596058572"},
+		{FormHexInt, "0x001122aabbcc"},
+		{FormOctal, "0o1044252535714"},
+		{FormBase64, "ABEiqrvM"},
+		{FormByteArray, "{0x00, 0x11, 0x22, 0xaa, 0xbb, 0xcc}"},
+		{FormEUI48, "00-11-22-AA-BB-CC"},
+	} {
+		got := m.FormatForm(test.f)
+		if got != test.want {
+			t.Errorf("%s: got %s, want %s", test.f, got, test.want)
+		}
+		p, err := TryParseForm(got, test.f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Compare(m) != 0 {
+			t.Errorf("%s: got %s, want %s", test.f, p, m)
+		}
+	}
+}
+
+// TestTryParseForm tests TryParseForm with alternative and invalid input
+func TestTryParseForm(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		f    Form
+		want string
+	}{
+		{"1", FormDecimal, "00:00:00:00:00:01"},
+		{"281474976710655", FormDecimal, "ff:ff:ff:ff:ff:ff"},
+		{"525400123456", FormHexInt, "52:54:00:12:34:56"},
+		{"0X525400123456", FormHexInt, "52:54:00:12:34:56"},
+		{"17", FormOctal, "00:00:00:00:00:0f"},
+		{"[82, 84, 0, 0x12, 0x34, 0x56,]", FormByteArray,
+			"52:54:00:12:34:56"},
+		{"52:54:00:12:34:56", FormEUI48, "52:54:00:12:34:56"},
+	} {
+		m, err := TryParseForm(test.s, test.f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := m.String(); got != test.want {
+			t.Errorf("%s: got %s, want %s", test.s, got, test.want)
+		}
+	}
+
+	for _, test := range []struct {
+		s    string
+		f    Form
+		want error
+	}{
+		{"281474976710656", FormDecimal, ErrInvalidLength},
+		{"-1", FormDecimal, ErrInvalidSyntax},
+		{"0x5254001234567", FormHexInt, ErrInvalidLength},
+		{"18", FormOctal, ErrInvalidSyntax},
+		{"ABEiqrvMzQ==", FormBase64, ErrInvalidLength},
+		{"{0x00, 0x11}", FormByteArray, ErrInvalidLength},
+		{"{0x00, 0x11, 0x22, 0xaa, 0xbb, 0x100}", FormByteArray,
+			ErrInvalidSyntax},
+	} {
+		_, err := TryParseForm(test.s, test.f)
+		if !errors.Is(err, test.want) {
+			t.Errorf("%s: got %v, want %v", test.s, err, test.want)
+		}
+	}
+}
+
+// TestLookupForm tests LookupForm
+func TestLookupForm(t *testing.T) {
+	for _, name := range Forms() {
+		f, err := LookupForm(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.String() != name {
+			t.Errorf("got %s, want %s", f, name)
+		}
+	}
+	if _, err := LookupForm("roman"); !errors.Is(err, ErrInvalidSyntax) {
+		t.Errorf("got %v, want %v", err, ErrInvalidSyntax)
+	}
+}
@@ -0,0 +1,146 @@
+package mac
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	mrand "math/rand/v2"
+
+	"github.com/hwipl/random-addr/internal/randsrc"
+)
+
+// defaultGenerator is the generator used by the package level Random
+// functions, it reads random bytes from crypto/rand
+var defaultGenerator = NewGenerator(rand.Reader)
+
+// Generator generates random MAC addresses from a source of random bytes
+type Generator struct {
+	r io.Reader
+}
+
+// Random returns a random MAC address or an error if reading random bytes
+// fails
+func (g *Generator) Random() (*MAC, error) {
+	m := &MAC{}
+	_, err := io.ReadFull(g.r, m.b[:])
+	if err != nil {
+		return nil, fmt.Errorf("mac: %w: %w", ErrRandomSource, err)
+	}
+
+	return m, nil
+}
+
+// randomULIG returns a random address with the U/L and I/G bits set
+// according to universal and individual
+func (g *Generator) randomULIG(universal, individual bool) (*MAC, error) {
+	m, err := g.Random()
+	if err != nil {
+		return nil, err
+	}
+	m.SetUL(universal)
+	m.SetIG(individual)
+	return m, nil
+}
+
+// RandomUI returns a random universal individual address
+func (g *Generator) RandomUI() (*MAC, error) {
+	return g.randomULIG(true, true)
+}
+
+// RandomUG returns a random universal group address
+func (g *Generator) RandomUG() (*MAC, error) {
+	return g.randomULIG(true, false)
+}
+
+// RandomLI returns a random local individual address
+func (g *Generator) RandomLI() (*MAC, error) {
+	return g.randomULIG(false, true)
+}
+
+// RandomLG returns a random local group address
+func (g *Generator) RandomLG() (*MAC, error) {
+	return g.randomULIG(false, false)
+}
+
+// uint64n returns a random number in [0, n)
+func (g *Generator) uint64n(n uint64) (uint64, error) {
+	if n <= 1 {
+		return 0, nil
+	}
+	// use rejection sampling to avoid modulo bias
+	max := ^uint64(0) - ^uint64(0)%n
+	for {
+		var b [8]byte
+		if _, err := io.ReadFull(g.r, b[:]); err != nil {
+			return 0, fmt.Errorf("mac: %w: %w", ErrRandomSource, err)
+		}
+		v := binary.BigEndian.Uint64(b[:])
+		if v < max {
+			return v % n, nil
+		}
+	}
+}
+
+// pickBlock returns the index of a random block in the prefix lengths in
+// bits, the pick is weighted by the block sizes, so every address in the
+// blocks is equally likely
+func (g *Generator) pickBlock(bits []int) (int, error) {
+	var total uint64
+	for _, b := range bits {
+		total += 1 << (48 - b)
+	}
+	v, err := g.uint64n(total)
+	if err != nil {
+		return 0, err
+	}
+	for i, b := range bits {
+		size := uint64(1) << (48 - b)
+		if v < size {
+			return i, nil
+		}
+		v -= size
+	}
+	return len(bits) - 1, nil
+}
+
+// RandomAssignment returns a random address inside one of the assignments,
+// the assignment is picked randomly weighted by its size
+func (g *Generator) RandomAssignment(assignments []*Assignment) (*MAC, error) {
+	if len(assignments) == 0 {
+		return nil, ErrNoAssignment
+	}
+	bits := make([]int, len(assignments))
+	for i, a := range assignments {
+		bits[i] = a.Bits
+	}
+	i, err := g.pickBlock(bits)
+	if err != nil {
+		return nil, err
+	}
+	m, err := g.Random()
+	if err != nil {
+		return nil, err
+	}
+	a := assignments[i]
+	m.setPrefixBits(a.Prefix, a.Bits)
+	return m, nil
+}
+
+// NewGenerator returns a new generator that reads random bytes from r
+func NewGenerator(r io.Reader) *Generator {
+	return &Generator{r: r}
+}
+
+// NewSourceGenerator returns a new generator that gets random bytes from the
+// random number source src
+func NewSourceGenerator(src mrand.Source) *Generator {
+	return NewGenerator(randsrc.Reader(src))
+}
+
+// NewKeyedGenerator returns a new generator that derives its bytes from
+// secret and name, so it always generates the same addresses for the same
+// secret and name, e.g., a stable address for each VM name
+func NewKeyedGenerator(secret []byte, name string) *Generator {
+	return NewGenerator(randsrc.Keyed(secret, "mac", name))
+}
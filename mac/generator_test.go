@@ -0,0 +1,204 @@
+package mac
+
+import (
+	"bytes"
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+// TestGeneratorRandom tests Random of Generator
+func TestGeneratorRandom(t *testing.T) {
+	// test same source, same addresses
+	g1 := NewSourceGenerator(rand.NewPCG(1, 2))
+	g2 := NewSourceGenerator(rand.NewPCG(1, 2))
+	for i := 0; i < 10; i++ {
+		a1, err := g1.Random()
+		if err != nil {
+			t.Fatal(err)
+		}
+		a2, err := g2.Random()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a1.String() != a2.String() {
+			t.Errorf("got %s, want %s", a2, a1)
+		}
+	}
+
+	// test reader
+	g := NewGenerator(bytes.NewReader(make([]byte, len(MAC{}.b))))
+	a, err := g.Random()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.b != (MAC{}).b {
+		t.Errorf("got %s, want zero address", a)
+	}
+
+	// test reader error
+	_, err = g.Random()
+	if !errors.Is(err, ErrRandomSource) {
+		t.Errorf("got %v, want %v", err, ErrRandomSource)
+	}
+}
+
+// TestGeneratorRandomULIG tests the U/L and I/G random functions of Generator
+func TestGeneratorRandomULIG(t *testing.T) {
+	g := NewSourceGenerator(rand.NewPCG(1, 2))
+	for _, test := range []struct {
+		random     func() (*MAC, error)
+		universal  bool
+		individual bool
+	}{
+		{g.RandomUI, true, true},
+		{g.RandomUG, true, false},
+		{g.RandomLI, false, true},
+		{g.RandomLG, false, false},
+	} {
+		m, err := test.random()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Universal() != test.universal {
+			t.Errorf("%s: got universal %t, want %t", m,
+				m.Universal(), test.universal)
+		}
+		if m.Individual() != test.individual {
+			t.Errorf("%s: got individual %t, want %t", m,
+				m.Individual(), test.individual)
+		}
+	}
+}
+
+// TestGeneratorRandomAssignment tests RandomAssignment of Generator
+func TestGeneratorRandomAssignment(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Load(strings.NewReader(testRegistryCSV)); err != nil {
+		t.Fatal(err)
+	}
+	found, err := r.FindVendor("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := NewSourceGenerator(rand.NewPCG(1, 2))
+	for i := 0; i < 100; i++ {
+		m, err := g.RandomAssignment(found)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !found[0].Contains(m) && !found[1].Contains(m) {
+			t.Errorf("%s not in assignments", m)
+		}
+	}
+
+	if _, err := g.RandomAssignment(nil); !errors.Is(err, ErrNoAssignment) {
+		t.Errorf("got %v, want %v", err, ErrNoAssignment)
+	}
+}
+
+// TestGeneratorRandomAssignmentWeighted tests that RandomAssignment of
+// Generator picks assignments weighted by their size
+func TestGeneratorRandomAssignmentWeighted(t *testing.T) {
+	small := &Assignment{Prefix: [6]byte{0x00, 0x55, 0xda, 0x50, 0x00},
+		Bits: MASBits}
+	large := &Assignment{Prefix: [6]byte{0x00, 0x50, 0x56}, Bits: MALBits}
+	other := &Assignment{Prefix: [6]byte{0x00, 0x0c, 0x29}, Bits: MALBits}
+
+	g := NewSourceGenerator(rand.NewPCG(1, 2))
+	counts := make(map[*Assignment]int)
+	for i := 0; i < 2000; i++ {
+		m, err := g.RandomAssignment([]*Assignment{small, large, other})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, a := range []*Assignment{small, large, other} {
+			if a.Contains(m) {
+				counts[a]++
+			}
+		}
+	}
+
+	// the MA-S block has 2^12 times fewer addresses than an MA-L block
+	if counts[small] > 5 {
+		t.Errorf("got %d addresses in MA-S block, want at most 5",
+			counts[small])
+	}
+	if counts[large] < 900 || counts[other] < 900 {
+		t.Errorf("got %d and %d addresses in MA-L blocks, want about "+
+			"1000 each", counts[large], counts[other])
+	}
+}
+
+// TestGeneratorRandomAssignmentBlocks tests RandomAssignment of Generator
+// with MA-M (28 bit) and MA-S (36 bit) blocks nested in an MA-L block
+func TestGeneratorRandomAssignmentBlocks(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Load(strings.NewReader(testRegistryCSV)); err != nil {
+		t.Fatal(err)
+	}
+
+	g := NewSourceGenerator(rand.NewPCG(1, 2))
+	for _, test := range []struct {
+		vendor string
+		bits   int
+	}{
+		{"Test MA-M", MAMBits},
+		{"Test MA-S", MASBits},
+	} {
+		found, err := r.FindVendor(test.vendor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(found) != 1 || found[0].Bits != test.bits {
+			t.Fatalf("%s: got %v, want one %d bit block",
+				test.vendor, found, test.bits)
+		}
+		seen := make(map[string]bool)
+		for i := 0; i < 100; i++ {
+			m, err := g.RandomAssignment(found)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the generated address must be in the block and the
+			// longest match must not be the enclosing MA-L block,
+			// the MA-M block also contains the MA-S block
+			if !found[0].Contains(m) {
+				t.Errorf("%s: %s not in %v", test.vendor, m, found[0])
+			}
+			if a := r.Lookup(m); a.Bits < test.bits {
+				t.Errorf("%s: %s is in %v, want %v", test.vendor,
+					m, a, found[0])
+			}
+			seen[m.String()] = true
+		}
+		if len(seen) < 90 {
+			t.Errorf("%s: got only %d distinct addresses", test.vendor,
+				len(seen))
+		}
+	}
+}
+
+// TestKeyedGenerator tests NewKeyedGenerator
+func TestKeyedGenerator(t *testing.T) {
+	derive := func(name string) string {
+		m, err := NewKeyedGenerator([]byte("secret"), name).RandomLI()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return m.String()
+	}
+
+	want := derive("vm1")
+	if got := derive("vm1"); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if got := derive("vm2"); got == want {
+		t.Errorf("got %s, want different address", got)
+	}
+	if m := Parse(want); !m.Local() || !m.Unicast() {
+		t.Errorf("got %s, want local unicast address", want)
+	}
+}
@@ -0,0 +1,29 @@
+#!/bin/sh
+# update.sh downloads the IEEE MA-L, MA-M, MA-S and CID registries and
+# stores them as gzip compressed snapshot with its date in this directory.
+# The mac package embeds the snapshot. Run it with "go generate ./mac" or
+# directly with "sh mac/ieee/update.sh". Set IEEE_BASE to use a mirror.
+set -eu
+
+dir=$(dirname "$0")
+base=${IEEE_BASE:-https://standards-oui.ieee.org}
+tmp=$(mktemp -d)
+trap 'rm -rf "$tmp"' EXIT
+
+for path in oui/oui.csv oui28/mam.csv oui36/oui36.csv cid/cid.csv; do
+	name=$(basename "$path")
+	curl -fsSL -o "$tmp/$name" "$base/$path"
+	if ! head -n 1 "$tmp/$name" | grep -q 'Registry,Assignment,'; then
+		echo "$name: unexpected file format" >&2
+		exit 1
+	fi
+done
+
+# replace the snapshot only after all downloads succeeded
+for name in oui.csv mam.csv oui36.csv cid.csv; do
+	gzip -9nc "$tmp/$name" > "$dir/$name.gz"
+done
+{
+	echo "date: $(date -u +%Y-%m-%d)"
+	echo "source: $base"
+} > "$dir/SNAPSHOT"
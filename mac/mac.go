@@ -1,10 +1,10 @@
 package mac
 
 import (
-	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"log"
-	"net"
+	"strings"
 )
 
 // MAC is a MAC address
@@ -14,14 +14,12 @@ type MAC struct {
 
 // Hex returns MAC as hex string
 func (m *MAC) Hex() string {
-	return fmt.Sprintf("%02x:%02x:%02x:%02x:%02x:%02x",
-		m.b[0], m.b[1], m.b[2], m.b[3], m.b[4], m.b[5])
+	return hexBytes(m.b[:])
 }
 
 // Binary returns MAC as a binary string
 func (m *MAC) Binary() string {
-	return fmt.Sprintf("%08b:%08b:%08b:%08b:%08b:%08b",
-		m.b[0], m.b[1], m.b[2], m.b[3], m.b[4], m.b[5])
+	return binBytes(m.b[:])
 }
 
 // String returns MAC as a string
@@ -29,10 +27,21 @@ func (m *MAC) String() string {
 	return m.Hex()
 }
 
+// Bytes returns MAC as a byte slice
+func (m *MAC) Bytes() []byte {
+	b := m.b
+	return b[:]
+}
+
+// BitLen returns the length of MAC in bits
+func (m *MAC) BitLen() int {
+	return len(m.b) * 8
+}
+
 // Universal returns true if MAC is globally unique,
 // i.e. Universal/Local (U/L) bit is 0
 func (m *MAC) Universal() bool {
-	return m.b[0]&0b00000010 == 0
+	return universal(m.b[:])
 }
 
 // Local returns true if MAC is locally administered,
@@ -43,15 +52,12 @@ func (m *MAC) Local() bool {
 
 // UL returns the U/L bit as string
 func (m *MAC) UL() string {
-	if m.Local() {
-		return "Local"
-	}
-	return "Universal"
+	return ulString(m.b[:])
 }
 
 // Individual returns true if MAC's Individual/Group (I/G) bit is 0
 func (m *MAC) Individual() bool {
-	return m.b[0]&0b00000001 == 0
+	return individual(m.b[:])
 }
 
 // Group returns true if MAC's Individual/Group (I/G) bit is 1
@@ -73,20 +79,22 @@ func (m *MAC) Multicast() bool {
 
 // IG returns the I/G (Unicast/Multicast) bit as a string
 func (m *MAC) IG() string {
-	if m.Group() {
-		return "Group (Multicast)"
-	}
-	return "Individual (Unicast)"
+	return igString(m.b[:])
+}
+
+// Type returns the type of MAC
+func (m *MAC) Type() string {
+	return typeString(m.b[:])
 }
 
 // OUI returns the OUI part of MAC as string
 func (m *MAC) OUI() string {
-	return fmt.Sprintf("%02x:%02x:%02x", m.b[0], m.b[1], m.b[2])
+	return hexBytes(m.b[:3])
 }
 
 // NIC returns the NIC specific part of MAC as string
 func (m *MAC) NIC() string {
-	return fmt.Sprintf("%02x:%02x:%02x", m.b[3], m.b[4], m.b[5])
+	return hexBytes(m.b[3:])
 }
 
 // Explain returns an explanation of the MAC and its structure as string
@@ -98,11 +106,28 @@ Hex:    %02x   :   %02x   :   %02x   :   %02x   :   %02x   :   %02x
 Bin: %s
            ||
            ||_ I/G: %s
-           |__ U/L: %s`,
+           |__ U/L: %s%s`,
 		m.OUI(), m.NIC(),
 		m.b[0], m.b[1], m.b[2], m.b[3], m.b[4], m.b[5],
 		m.Binary(),
 		m.IG(), m.UL(),
+		m.explainSLAP(),
+	) + m.explainWellKnown() + m.explainPreset()
+}
+
+// explainSLAP returns an explanation of the Y and Z bits of local addresses
+// as string
+func (m *MAC) explainSLAP() string {
+	if m.Universal() {
+		return ""
+	}
+	return fmt.Sprintf(`
+         ||
+         ||___ Y: %d
+         |____ Z: %d
+SLAP: %s`,
+		(m.b[0]&bitY)>>2, (m.b[0]&bitZ)>>3,
+		m.Quadrant(),
 	)
 }
 
@@ -141,15 +166,23 @@ func (m *MAC) All() string {
 	return fmt.Sprintf(`Hex:          %s
 OUI:          %s
 NIC specific: %s
+Vendor:       %s
 Binary:       %s
+Bit-reversed: %s
 U/L:          %s
-I/G:          %s`,
+I/G:          %s
+SLAP:         %s
+Well-known:   %s`,
 		m.Hex(),
 		m.OUI(),
 		m.NIC(),
+		m.vendorString(0),
 		m.Binary(),
+		m.BitReversed(),
 		m.UL(),
 		m.IG(),
+		m.Quadrant(),
+		m.wellKnownString(),
 	)
 }
 
@@ -160,46 +193,50 @@ func (m *MAC) Table() string {
 | Hex          | %-53s |
 | OUI          | %-53s |
 | NIC specific | %-53s |
+| Vendor       | %-53s |
 | Binary       | %-53s |
+| Bit-reversed | %-53s |
 | U/L          | %-53s |
 | I/G          | %-53s |
+| SLAP         | %-53s |
+| Well-known   | %-53s |
  ----------------------------------------------------------------------`,
 		m.Hex(),
 		m.OUI(),
 		m.NIC(),
+		m.vendorString(53),
 		m.Binary(),
+		m.BitReversed(),
 		m.UL(),
 		m.IG(),
+		m.Quadrant(),
+		m.wellKnownString(),
 	)
 }
 
 // SetUniversal sets the U/L bit of the MAC address to 0 (universal)
 func (m *MAC) SetUniversal() {
-	m.b[0] &= 0b11111101
+	setUL(m.b[:], true)
 }
 
 // SetLocal sets the U/L bit of the MAC address to 1 (local)
 func (m *MAC) SetLocal() {
-	m.b[0] |= 0b00000010
+	setUL(m.b[:], false)
 }
 
 // SetUL sets if the address is universal via the U/L bit
 func (m *MAC) SetUL(universal bool) {
-	if universal {
-		m.SetUniversal()
-		return
-	}
-	m.SetLocal()
+	setUL(m.b[:], universal)
 }
 
 // SetIndividual sets the I/G bit of the MAC address to 0 (individual)
 func (m *MAC) SetIndividual() {
-	m.b[0] &= 0b11111110
+	setIG(m.b[:], true)
 }
 
 // SetGroup sets the I/G bit of the MAC address to 1 (group)
 func (m *MAC) SetGroup() {
-	m.b[0] |= 0b00000001
+	setIG(m.b[:], false)
 }
 
 // SetUnicast sets I/G bit of the MAC address to unicast (individual)
@@ -214,11 +251,7 @@ func (m *MAC) SetMulticast() {
 
 // SetIG sets if the address is individual via the I/G bit
 func (m *MAC) SetIG(individual bool) {
-	if individual {
-		m.SetIndividual()
-		return
-	}
-	m.SetGroup()
+	setIG(m.b[:], individual)
 }
 
 // SetOUI sets the OUI part of the MAC
@@ -235,10 +268,28 @@ func (m *MAC) SetNIC(nic [3]byte) {
 	}
 }
 
+// setPrefixBits sets the first bits of the MAC to the first bits of prefix
+func (m *MAC) setPrefixBits(prefix [6]byte, bits int) {
+	for i := 0; i < len(m.b) && bits > 0; i++ {
+		if bits < 8 {
+			mask := byte(0xff << (8 - bits))
+			m.b[i] = m.b[i]&^mask | prefix[i]&mask
+			break
+		}
+		m.b[i] = prefix[i]
+		bits -= 8
+	}
+}
+
+// TryRandom returns a random MAC address or an error if reading random
+// bytes fails
+func TryRandom() (*MAC, error) {
+	return defaultGenerator.Random()
+}
+
 // Random returns a random MAC address
 func Random() *MAC {
-	m := &MAC{}
-	_, err := rand.Read(m.b[:])
+	m, err := TryRandom()
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -246,12 +297,23 @@ func Random() *MAC {
 	return m
 }
 
+// must returns the value created by f and exits on error
+func must[T any](f func() (T, error)) T {
+	a, err := f()
+	if err != nil {
+		log.Fatal(err)
+	}
+	return a
+}
+
+// TryRandomUI returns a random universal individual address or an error
+func TryRandomUI() (*MAC, error) {
+	return defaultGenerator.RandomUI()
+}
+
 // RandomUI returns a random universal individual address
 func RandomUI() *MAC {
-	m := Random()
-	m.SetUniversal()
-	m.SetIndividual()
-	return m
+	return must(TryRandomUI)
 }
 
 // RandomUU returns a random universal unicast address
@@ -259,12 +321,14 @@ func RandomUU() *MAC {
 	return RandomUI()
 }
 
+// TryRandomUG returns a random universal group address or an error
+func TryRandomUG() (*MAC, error) {
+	return defaultGenerator.RandomUG()
+}
+
 // RandomUG returns a random universal group address
 func RandomUG() *MAC {
-	m := Random()
-	m.SetUniversal()
-	m.SetGroup()
-	return m
+	return must(TryRandomUG)
 }
 
 // RandomUM returns a random universal multicast address
@@ -272,12 +336,14 @@ func RandomUM() *MAC {
 	return RandomUG()
 }
 
+// TryRandomLI returns a random local individual address or an error
+func TryRandomLI() (*MAC, error) {
+	return defaultGenerator.RandomLI()
+}
+
 // RandomLI returns a random local individual address
 func RandomLI() *MAC {
-	m := Random()
-	m.SetLocal()
-	m.SetIndividual()
-	return m
+	return must(TryRandomLI)
 }
 
 // RandomLU returns a random local unicast address
@@ -285,12 +351,14 @@ func RandomLU() *MAC {
 	return RandomLI()
 }
 
+// TryRandomLG returns a random local group address or an error
+func TryRandomLG() (*MAC, error) {
+	return defaultGenerator.RandomLG()
+}
+
 // RandomLG returns a random local group address
 func RandomLG() *MAC {
-	m := Random()
-	m.SetLocal()
-	m.SetGroup()
-	return m
+	return must(TryRandomLG)
 }
 
 // RandomLM returns a random local multicast address
@@ -298,21 +366,68 @@ func RandomLM() *MAC {
 	return RandomLG()
 }
 
-// Parse parses and returns the MAC address in s
-func Parse(s string) *MAC {
+// TryParse parses and returns the MAC address in s or an error if s is not
+// a valid MAC address, s can be in any Notation
+func TryParse(s string) (*MAC, error) {
 	mac := &MAC{}
+	if err := parseBytes(s, mac.b[:]); err != nil {
+		return nil, err
+	}
+	return mac, nil
+}
 
-	hw, err := net.ParseMAC(s)
+// Parse parses and returns the MAC address in s
+func Parse(s string) *MAC {
+	mac, err := TryParse(s)
 	if err != nil {
 		log.Fatal(err)
 	}
-	if len(hw) != 6 {
-		log.Fatal("invalid MAC address")
+
+	return mac
+}
+
+// parseHexBytes parses the n hex bytes in s, bytes are either not separated
+// or all separated by the same colon, hyphen or dot
+func parseHexBytes(s string, n int) ([]byte, error) {
+	h := s
+	if len(s) > 2 && strings.ContainsAny(s[2:3], ":-.") {
+		groups := strings.Split(s, s[2:3])
+		for _, g := range groups {
+			if len(g) != 2 {
+				return nil, &ParseError{Input: s, Err: ErrInvalidSyntax}
+			}
+		}
+		h = strings.Join(groups, "")
 	}
+	if len(h) != 2*n {
+		if _, err := hex.DecodeString(h); err == nil {
+			return nil, &ParseError{Input: s, Err: ErrInvalidLength}
+		}
+		return nil, &ParseError{Input: s, Err: ErrInvalidSyntax}
+	}
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return nil, &ParseError{Input: s, Err: ErrInvalidSyntax}
+	}
+	return b, nil
+}
 
-	for i := 0; i < 6; i++ {
-		mac.b[i] = hw[i]
+// ParseOUI parses and returns the OUI in s, e.g., "52:54:00"
+func ParseOUI(s string) (oui [3]byte, err error) {
+	b, err := parseHexBytes(s, len(oui))
+	if err != nil {
+		return
 	}
+	copy(oui[:], b)
+	return
+}
 
-	return mac
+// ParseNIC parses and returns the NIC specific part in s, e.g., "12:34:56"
+func ParseNIC(s string) (nic [3]byte, err error) {
+	b, err := parseHexBytes(s, len(nic))
+	if err != nil {
+		return
+	}
+	copy(nic[:], b)
+	return
 }
@@ -1,6 +1,8 @@
 package mac
 
 import (
+	"bytes"
+	"errors"
 	"testing"
 )
 
@@ -196,3 +198,114 @@ func TestParse(t *testing.T) {
 		t.Errorf("got %s, want %s", got, want)
 	}
 }
+
+// TestTryParse tests TryParse
+func TestTryParse(t *testing.T) {
+	// test valid mac
+	want := "00:00:5e:00:53:01"
+	m, err := TryParse(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := m.String(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	// test invalid syntax
+	_, err = TryParse("00:00:5e:00:53")
+	if !errors.Is(err, ErrInvalidSyntax) {
+		t.Errorf("got %v, want %v", err, ErrInvalidSyntax)
+	}
+
+	// test invalid length
+	_, err = TryParse("00:00:5e:00:53:01:02:03")
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("got %v, want %v", err, ErrInvalidLength)
+	}
+	var perr *ParseError
+	if !errors.As(err, &perr) {
+		t.Errorf("error is not a ParseError")
+	}
+}
+
+// TestParseOUI tests ParseOUI
+func TestParseOUI(t *testing.T) {
+	want := [3]byte{0x52, 0x54, 0x00}
+	for _, s := range []string{"52:54:00", "52-54-00", "52.54.00", "525400"} {
+		got, err := ParseOUI(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	}
+
+	// test invalid OUIs
+	if _, err := ParseOUI("52:54"); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("got %v, want %v", err, ErrInvalidLength)
+	}
+	for _, s := range []string{
+		"52:54:zz",
+		"5:25:400",
+		"52-54.00",
+		"52:5400",
+		"52::54:00",
+		":52:54:00",
+	} {
+		if _, err := ParseOUI(s); !errors.Is(err, ErrInvalidSyntax) {
+			t.Errorf("%s: got %v, want %v", s, err, ErrInvalidSyntax)
+		}
+	}
+}
+
+// TestParseNIC tests ParseNIC
+func TestParseNIC(t *testing.T) {
+	want := [3]byte{0x12, 0x34, 0x56}
+	got, err := ParseNIC("12:34:56")
+	if err != nil || got != want {
+		t.Errorf("got %v %v, want %v", got, err, want)
+	}
+
+	// test invalid NICs
+	if _, err := ParseNIC("12:34:56:78"); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("got %v, want %v", err, ErrInvalidLength)
+	}
+	if _, err := ParseNIC("1.23.456"); !errors.Is(err, ErrInvalidSyntax) {
+		t.Errorf("got %v, want %v", err, ErrInvalidSyntax)
+	}
+}
+
+// TestBytes tests Bytes of MAC
+func TestBytes(t *testing.T) {
+	m := Parse("00:00:5e:00:53:01")
+	want := []byte{0x00, 0x00, 0x5e, 0x00, 0x53, 0x01}
+	got := m.Bytes()
+	if !bytes.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// make sure changing the bytes does not change the MAC
+	got[0] = 0xff
+	if m.Bytes()[0] != 0x00 {
+		t.Errorf("MAC changed")
+	}
+}
+
+// TestType tests Type of MAC
+func TestType(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want string
+	}{
+		{"00:00:5e:00:53:01", "universal unicast"},
+		{"01:00:5e:00:53:01", "universal multicast"},
+		{"02:00:5e:00:53:01", "local unicast"},
+		{"03:00:5e:00:53:01", "local multicast"},
+	} {
+		got := Parse(test.s).Type()
+		if got != test.want {
+			t.Errorf("got %s, want %s", got, test.want)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package mac
+
+import (
+	"encoding/hex"
+	"strings"
+)
+
+// Notation is a notation of MAC addresses as string
+type Notation int
+
+// notations
+const (
+	// NotationColon is the colon separated notation, e.g.,
+	// 00:11:22:33:44:55
+	NotationColon Notation = iota
+
+	// NotationHyphen is the hyphen separated notation, e.g.,
+	// 00-11-22-33-44-55
+	NotationHyphen
+
+	// NotationCisco is the dot separated notation used by Cisco, e.g.,
+	// 0011.2233.4455
+	NotationCisco
+
+	// NotationBare is the notation without separators, e.g., 001122334455
+	NotationBare
+
+	// NotationPostgreSQL is the notation used by PostgreSQL, e.g.,
+	// 001122:334455
+	NotationPostgreSQL
+)
+
+// NotationUpper can be combined with a notation to use upper case hex
+// digits, e.g., NotationHyphen|NotationUpper is 00-11-22-AA-BB-CC
+const NotationUpper Notation = 1 << 8
+
+// notationNames are the names of the notations
+var notationNames = []string{
+	NotationColon:      "colon",
+	NotationHyphen:     "hyphen",
+	NotationCisco:      "cisco",
+	NotationBare:       "bare",
+	NotationPostgreSQL: "postgresql",
+}
+
+// Notations returns the names of all notations accepted by ParseNotation
+// without the "upper-" prefix
+func Notations() []string {
+	return append([]string(nil), notationNames...)
+}
+
+// String returns n as string
+func (n Notation) String() string {
+	base := n &^ NotationUpper
+	if base < 0 || int(base) >= len(notationNames) {
+		return "unknown"
+	}
+	if n&NotationUpper != 0 {
+		return "upper-" + notationNames[base]
+	}
+	return notationNames[base]
+}
+
+// ParseNotation parses the notation name in s, e.g., "cisco" or
+// "upper-hyphen", the name "upper" is the colon notation in upper case
+func ParseNotation(s string) (Notation, error) {
+	name := strings.ToLower(s)
+	if name == "upper" {
+		return NotationColon | NotationUpper, nil
+	}
+	var upper Notation
+	if n, ok := strings.CutPrefix(name, "upper-"); ok {
+		name = n
+		upper = NotationUpper
+	}
+	for i, n := range notationNames {
+		if n == name {
+			return Notation(i) | upper, nil
+		}
+	}
+	return NotationColon, &ParseError{Input: s, Err: ErrInvalidSyntax}
+}
+
+// groupHex returns the hex string h in groups of size separated by sep
+func groupHex(h string, size int, sep string) string {
+	groups := make([]string, 0, len(h)/size)
+	for i := 0; i < len(h); i += size {
+		groups = append(groups, h[i:i+size])
+	}
+	return strings.Join(groups, sep)
+}
+
+// Format returns the MAC as string in notation n
+func (m *MAC) Format(n Notation) string {
+	h := hex.EncodeToString(m.b[:])
+	switch n &^ NotationUpper {
+	case NotationHyphen:
+		h = groupHex(h, 2, "-")
+	case NotationCisco:
+		h = groupHex(h, 4, ".")
+	case NotationBare:
+	case NotationPostgreSQL:
+		h = groupHex(h, 6, ":")
+	default:
+		h = groupHex(h, 2, ":")
+	}
+	if n&NotationUpper != 0 {
+		h = strings.ToUpper(h)
+	}
+	return h
+}
+
+// parseCompact parses the address with n bytes in s if it is in bare or
+// PostgreSQL notation, it returns false if s is in another notation
+func parseCompact(s string, n int) ([]byte, bool) {
+	if len(s) == 2*n+1 && (s[n] == ':' || s[n] == '-') {
+		s = s[:n] + s[n+1:]
+	}
+	if len(s) != 2*n {
+		return nil, false
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, false
+	}
+	return b, true
+}
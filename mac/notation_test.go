@@ -0,0 +1,86 @@
+package mac
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestFormat tests Format
+func TestFormat(t *testing.T) {
+	m := Parse("00:11:22:aa:bb:cc")
+	for _, test := range []struct {
+		n    Notation
+		want string
+	}{
+		{NotationColon, "00:11:22:aa:bb:cc"},
+		{NotationHyphen, "00-11-22-aa-bb-cc"},
+		{NotationCisco, "0011.22aa.bbcc"},
+		{NotationBare, "001122aabbcc"},
+		{NotationPostgreSQL, "001122:aabbcc"},
+		{NotationHyphen | NotationUpper, "00-11-22-AA-BB-CC"},
+	} {
+		got := m.Format(test.n)
+		if got != test.want {
+			t.Errorf("got %s, want %s", got, test.want)
+		}
+	}
+}
+
+// TestParseNotation tests ParseNotation
+func TestParseNotation(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want Notation
+	}{
+		{"colon", NotationColon},
+		{"Cisco", NotationCisco},
+		{"upper", NotationColon | NotationUpper},
+		{"upper-hyphen", NotationHyphen | NotationUpper},
+	} {
+		got, err := ParseNotation(test.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("got %s, want %s", got, test.want)
+		}
+		if n, _ := ParseNotation(got.String()); n != got {
+			t.Errorf("got %s, want %s", n, got)
+		}
+	}
+
+	if _, err := ParseNotation("dotted"); !errors.Is(err, ErrInvalidSyntax) {
+		t.Errorf("got %v, want %v", err, ErrInvalidSyntax)
+	}
+}
+
+// TestParseNotations tests Parse with all notations
+func TestParseNotations(t *testing.T) {
+	want := "00:11:22:aa:bb:cc"
+	for _, s := range []string{
+		"00:11:22:aa:bb:cc",
+		"00-11-22-AA-BB-CC",
+		"0011.22aa.bbcc",
+		"001122aabbcc",
+		"001122:aabbcc",
+		"001122-AABBCC",
+	} {
+		m, err := TryParse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := m.String(); got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+
+	for _, s := range []string{
+		"001122aabbc",
+		"001122aabbcx",
+		"00112:2aabbcc",
+	} {
+		if _, err := TryParse(s); err == nil {
+			t.Errorf("%s: got nil, want error", s)
+		}
+	}
+}
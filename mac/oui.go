@@ -0,0 +1,365 @@
+package mac
+
+import (
+	"bufio"
+	"compress/gzip"
+	"embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// ieeeFiles contains a snapshot of the IEEE MA-L, MA-M, MA-S and CID
+// registries in the gzip compressed CSV format published by the IEEE and
+// the date of the snapshot, ieee/update.sh regenerates it
+//
+//go:generate sh ieee/update.sh
+//go:embed ieee/*.csv.gz ieee/SNAPSHOT
+var ieeeFiles embed.FS
+
+// ieeeFileNames are the names of the embedded IEEE registry files
+var ieeeFileNames = []string{
+	"ieee/oui.csv.gz",
+	"ieee/mam.csv.gz",
+	"ieee/oui36.csv.gz",
+	"ieee/cid.csv.gz",
+}
+
+// assignment block sizes in bits
+const (
+	// MALBits is the length of an MA-L (OUI) assignment in bits
+	MALBits = 24
+
+	// MAMBits is the length of an MA-M assignment in bits
+	MAMBits = 28
+
+	// MASBits is the length of an MA-S (OUI-36) assignment in bits
+	MASBits = 36
+)
+
+// Assignment is a block of MAC addresses assigned to an organization
+type Assignment struct {
+	// Registry is the IEEE registry, e.g., "MA-L" or "CID"
+	Registry string
+
+	// Prefix contains the assigned bits, left aligned, the unused bits
+	// are 0
+	Prefix [6]byte
+
+	// Bits is the length of the prefix in bits
+	Bits int
+
+	// Organization is the name of the organization
+	Organization string
+
+	// Address is the address of the organization
+	Address string
+}
+
+// Block returns the assigned block of a as string, e.g.,
+// "00:50:56:00:00:00/24"
+func (a *Assignment) Block() string {
+	return fmt.Sprintf("%s/%d", (&MAC{b: a.Prefix}).Hex(), a.Bits)
+}
+
+// String returns a as string
+func (a *Assignment) String() string {
+	return fmt.Sprintf("%s %s", a.Block(), a.Organization)
+}
+
+// Contains returns whether m is inside the assigned block
+func (a *Assignment) Contains(m *MAC) bool {
+	return prefixKey(m.b, a.Bits) == prefixKey(a.Prefix, a.Bits)
+}
+
+// Range returns the assigned block of a as prefix
+func (a *Assignment) Range() *Prefix {
+	return newPrefix(a.Prefix, a.Bits)
+}
+
+// prefixKey returns the first bits of b as integer
+func prefixKey(b [6]byte, bits int) uint64 {
+	var k uint64
+	for _, x := range b {
+		k = k<<8 | uint64(x)
+	}
+	return k >> (48 - bits)
+}
+
+// Registry is a database of MAC address block assignments
+type Registry struct {
+	mutex sync.RWMutex
+
+	// blocks maps the prefix length and prefix to the assignment
+	blocks map[int]map[uint64]*Assignment
+}
+
+// Add adds the assignment a to r, it replaces an existing assignment of the
+// same block
+func (r *Registry) Add(a *Assignment) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.blocks == nil {
+		r.blocks = make(map[int]map[uint64]*Assignment)
+	}
+	if r.blocks[a.Bits] == nil {
+		r.blocks[a.Bits] = make(map[uint64]*Assignment)
+	}
+	r.blocks[a.Bits][prefixKey(a.Prefix, a.Bits)] = a
+}
+
+// Lookup returns the assignment containing m or nil if m is not assigned,
+// the longest matching assignment wins
+func (r *Registry) Lookup(m *MAC) *Assignment {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for _, bits := range []int{MASBits, MAMBits, MALBits} {
+		if a := r.blocks[bits][prefixKey(m.b, bits)]; a != nil {
+			return a
+		}
+	}
+	return nil
+}
+
+// Assignments returns all assignments in r sorted by prefix
+func (r *Registry) Assignments() []*Assignment {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var all []*Assignment
+	for _, bits := range []int{MALBits, MAMBits, MASBits} {
+		for _, a := range r.blocks[bits] {
+			all = append(all, a)
+		}
+	}
+	sort.Slice(all, func(i, j int) bool {
+		ki := prefixKey(all[i].Prefix, 48)
+		kj := prefixKey(all[j].Prefix, 48)
+		if ki != kj {
+			return ki < kj
+		}
+		return all[i].Bits < all[j].Bits
+	})
+	return all
+}
+
+// Find returns all assignments in r with an organization name matching re
+// sorted by prefix
+func (r *Registry) Find(re *regexp.Regexp) []*Assignment {
+	var found []*Assignment
+	for _, a := range r.Assignments() {
+		if re.MatchString(a.Organization) {
+			found = append(found, a)
+		}
+	}
+	return found
+}
+
+// FindVendor returns all assignments in r with an organization name
+// matching vendor, vendor is a case-insensitive regular expression or name
+func (r *Registry) FindVendor(vendor string) ([]*Assignment, error) {
+	re, err := regexp.Compile("(?i)" + vendor)
+	if err != nil {
+		return nil, err
+	}
+	return r.Find(re), nil
+}
+
+// Len returns the number of assignments in r
+func (r *Registry) Len() int {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	n := 0
+	for _, blocks := range r.blocks {
+		n += len(blocks)
+	}
+	return n
+}
+
+// parseAssignment parses the hex assignment s, e.g., "0050C2" or "70B3D5123"
+func parseAssignment(s string) (prefix [6]byte, bits int, err error) {
+	bits = len(s) * 4
+	v, err := strconv.ParseUint(s, 16, 64)
+	if err != nil || (bits != MALBits && bits != MAMBits && bits != MASBits) {
+		err = fmt.Errorf("%w: assignment %q", ErrInvalidRegistry, s)
+		return prefix, 0, err
+	}
+	v <<= 48 - bits
+	for i := len(prefix) - 1; i >= 0; i-- {
+		prefix[i] = byte(v)
+		v >>= 8
+	}
+	return prefix, bits, nil
+}
+
+// Load loads assignments from the IEEE registry CSV file in rd, e.g.,
+// oui.csv, mam.csv, oui36.csv or cid.csv
+func (r *Registry) Load(rd io.Reader) error {
+	cr := csv.NewReader(rd)
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRegistry, err)
+	}
+	for i, rec := range records {
+		if i == 0 && len(rec) > 0 && rec[0] == "Registry" {
+			// skip header
+			continue
+		}
+		if len(rec) < 3 {
+			return fmt.Errorf("%w: line %d", ErrInvalidRegistry, i+1)
+		}
+		prefix, bits, err := parseAssignment(strings.TrimSpace(rec[1]))
+		if err != nil {
+			return err
+		}
+		a := &Assignment{
+			Registry:     strings.TrimSpace(rec[0]),
+			Prefix:       prefix,
+			Bits:         bits,
+			Organization: strings.TrimSpace(rec[2]),
+		}
+		if len(rec) > 3 {
+			a.Address = strings.TrimSpace(rec[3])
+		}
+		r.Add(a)
+	}
+	return nil
+}
+
+// LoadFile loads assignments from the IEEE registry CSV file in path
+func (r *Registry) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := r.Load(f); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// NewRegistry returns a new empty registry
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// loadEmbedded loads the gzip compressed embedded registry file name into r
+func (r *Registry) loadEmbedded(name string) error {
+	f, err := ieeeFiles.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w: %w", name, ErrInvalidRegistry, err)
+	}
+	if err := r.Load(zr); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+// NewEmbeddedRegistry returns a new registry that contains the embedded
+// snapshot of the IEEE registries or an error if the snapshot is malformed
+func NewEmbeddedRegistry() (*Registry, error) {
+	r := NewRegistry()
+	for _, name := range ieeeFileNames {
+		if err := r.loadEmbedded(name); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// EmbeddedRegistryDate returns the date of the embedded snapshot of the IEEE
+// registries, e.g., "2025-01-31", or "unknown"
+func EmbeddedRegistryDate() string {
+	f, err := ieeeFiles.Open("ieee/SNAPSHOT")
+	if err != nil {
+		return "unknown"
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		if date, ok := strings.CutPrefix(s.Text(), "date: "); ok {
+			return date
+		}
+	}
+	return "unknown"
+}
+
+var (
+	// defaultRegistryMutex protects defaultRegistry
+	defaultRegistryMutex sync.Mutex
+
+	// defaultRegistry is the registry used by Vendor
+	defaultRegistry *Registry
+)
+
+// DefaultRegistry returns the registry used by Vendor, it is the embedded
+// registry unless set with SetDefaultRegistry
+func DefaultRegistry() *Registry {
+	defaultRegistryMutex.Lock()
+	defer defaultRegistryMutex.Unlock()
+
+	if defaultRegistry == nil {
+		// the embedded snapshot is checked by the tests, fall back to
+		// an empty registry if it is malformed anyway
+		r, err := NewEmbeddedRegistry()
+		if err != nil {
+			r = NewRegistry()
+		}
+		defaultRegistry = r
+	}
+	return defaultRegistry
+}
+
+// SetDefaultRegistry sets the registry used by Vendor to r
+func SetDefaultRegistry(r *Registry) {
+	defaultRegistryMutex.Lock()
+	defer defaultRegistryMutex.Unlock()
+
+	defaultRegistry = r
+}
+
+// Vendor returns the organization the MAC or, in the ELI quadrant, its
+// Company ID is assigned to in the default registry or an empty string if it
+// is unknown
+func (m *MAC) Vendor() string {
+	if a := DefaultRegistry().Lookup(m); a != nil {
+		return a.Organization
+	}
+	return ""
+}
+
+// vendorString returns the vendor of the MAC for display, it is shortened to
+// max characters if max is greater than 0
+func (m *MAC) vendorString(max int) string {
+	v := m.Vendor()
+	if v == "" {
+		return "Unknown"
+	}
+	return truncate(v, max)
+}
+
+// truncate returns s shortened to max runes with "..." at the end if it is
+// longer, max 0 means no limit
+func truncate(s string, max int) string {
+	if max <= 0 || utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max-3]) + "..."
+}
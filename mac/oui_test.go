@@ -0,0 +1,165 @@
+package mac
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// testRegistryCSV is a registry file with nested assignments
+const testRegistryCSV = `Registry,Assignment,Organization Name,Organization Address
+MA-L,70B3D5,IEEE Registration Authority,445 Hoes Lane Piscataway NJ US 08554
+MA-M,70B3D51,Test MA-M,Test Address
+MA-S,70B3D5123,"Test MA-S, Inc.",Test Address
+`
+
+// TestRegistryLookup tests Lookup of Registry
+func TestRegistryLookup(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Load(strings.NewReader(testRegistryCSV)); err != nil {
+		t.Fatal(err)
+	}
+	if r.Len() != 3 {
+		t.Errorf("got %d, want 3", r.Len())
+	}
+
+	for _, test := range []struct {
+		mac  string
+		want string
+	}{
+		{"70:b3:d5:01:23:45", "IEEE Registration Authority"},
+		{"70:b3:d5:11:23:45", "Test MA-M"},
+		{"70:b3:d5:12:34:56", "Test MA-S, Inc."},
+		{"70:b3:d5:12:44:56", "Test MA-M"},
+	} {
+		a := r.Lookup(Parse(test.mac))
+		if a == nil {
+			t.Fatalf("%s: no assignment", test.mac)
+		}
+		if a.Organization != test.want {
+			t.Errorf("%s: got %s, want %s", test.mac, a.Organization,
+				test.want)
+		}
+	}
+
+	if a := r.Lookup(Parse("00:00:00:00:00:01")); a != nil {
+		t.Errorf("got %s, want nil", a)
+	}
+}
+
+// TestRegistryLoadQuoted tests Load of Registry with quoted fields that
+// contain commas
+func TestRegistryLoadQuoted(t *testing.T) {
+	in := `Registry,Assignment,Organization Name,Organization Address
+MA-L,0000F0,"Samsung Electronics Co.,Ltd","Street 1, City"
+`
+	r := NewRegistry()
+	if err := r.Load(strings.NewReader(in)); err != nil {
+		t.Fatal(err)
+	}
+	a := r.Lookup(Parse("00:00:f0:11:22:33"))
+	if a == nil {
+		t.Fatal("no assignment")
+	}
+	if a.Organization != "Samsung Electronics Co.,Ltd" ||
+		a.Address != "Street 1, City" {
+		t.Errorf("got %q %q, want Samsung Electronics Co.,Ltd and "+
+			"Street 1, City", a.Organization, a.Address)
+	}
+
+	// the embedded registry is generated with the same quoting
+	r, err := NewEmbeddedRegistry()
+	if err != nil {
+		t.Fatal(err)
+	}
+	a = r.Lookup(Parse("00:00:f0:11:22:33"))
+	if a == nil || a.Organization != "Samsung Electronics Co.,Ltd" {
+		t.Errorf("got %v, want Samsung Electronics Co.,Ltd", a)
+	}
+}
+
+// TestRegistryLoad tests Load of Registry with invalid files
+func TestRegistryLoad(t *testing.T) {
+	for _, s := range []string{
+		"MA-L,70B3D51Z,Invalid\n",
+		"MA-L,70B3,Invalid\n",
+		"MA-L\n",
+	} {
+		err := NewRegistry().Load(strings.NewReader(s))
+		if !errors.Is(err, ErrInvalidRegistry) {
+			t.Errorf("got %v, want %v", err, ErrInvalidRegistry)
+		}
+	}
+}
+
+// TestEmbeddedRegistry tests NewEmbeddedRegistry and the snapshot date
+func TestEmbeddedRegistry(t *testing.T) {
+	r, err := NewEmbeddedRegistry()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Len() == 0 {
+		t.Errorf("got empty embedded registry")
+	}
+	if EmbeddedRegistryDate() == "" {
+		t.Errorf("got empty snapshot date")
+	}
+}
+
+// TestVendor tests Vendor of MAC with the embedded registry
+func TestVendor(t *testing.T) {
+	want := "Cisco Systems, Inc"
+	got := Parse("00:00:0c:12:34:56").Vendor()
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	got = Parse("02:00:00:00:00:01").Vendor()
+	if got != "" {
+		t.Errorf("got %s, want empty vendor", got)
+	}
+}
+
+// TestRegistryFindVendor tests FindVendor of Registry
+func TestRegistryFindVendor(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Load(strings.NewReader(testRegistryCSV)); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := r.FindVendor("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 2 {
+		t.Fatalf("got %d, want 2", len(found))
+	}
+	if found[0].Bits != MAMBits || found[1].Bits != MASBits {
+		t.Errorf("got %s, %s, want MA-M, MA-S", found[0], found[1])
+	}
+
+	if _, err := r.FindVendor("("); err == nil {
+		t.Errorf("invalid regular expression accepted")
+	}
+}
+
+// TestTruncate tests truncate with multi-byte characters
+func TestTruncate(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		max  int
+		want string
+	}{
+		{"Prüftechnik", 0, "Prüftechnik"},
+		{"Prüftechnik", 11, "Prüftechnik"},
+		{"Prüftechnik", 6, "Prü..."},
+		{"Institut für Elektronik", 16, "Institut für ..."},
+	} {
+		got := truncate(test.s, test.max)
+		if got != test.want || !utf8.ValidString(got) {
+			t.Errorf("%q %d: got %q, want %q", test.s, test.max, got,
+				test.want)
+		}
+	}
+}
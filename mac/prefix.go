@@ -0,0 +1,140 @@
+package mac
+
+import (
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+)
+
+// Prefix is a MAC address prefix, i.e., a block of MAC addresses that share
+// the first bits, e.g., 52:54:00:00:00:00/24
+type Prefix struct {
+	addr MAC
+	bits int
+}
+
+// NewPrefix returns the prefix with the first bits of m or an error if bits
+// is out of range
+func NewPrefix(m *MAC, bits int) (*Prefix, error) {
+	if bits < 0 || bits > 48 {
+		return nil, fmt.Errorf("mac: %w: %d", ErrPrefixLength, bits)
+	}
+	return newPrefix(m.b, bits), nil
+}
+
+// newPrefix returns the prefix with the first bits of b, bits must be in
+// range
+func newPrefix(b [6]byte, bits int) *Prefix {
+	p := &Prefix{bits: bits}
+	p.addr.setPrefixBits(b, bits)
+	return p
+}
+
+// Addr returns the first address of p
+func (p *Prefix) Addr() *MAC {
+	m := p.addr
+	return &m
+}
+
+// Bits returns the prefix length of p
+func (p *Prefix) Bits() int {
+	return p.bits
+}
+
+// String returns p as string, e.g., 52:54:00:00:00:00/24
+func (p *Prefix) String() string {
+	return fmt.Sprintf("%s/%d", p.addr.Hex(), p.bits)
+}
+
+// Size returns the number of addresses in p
+func (p *Prefix) Size() uint64 {
+	return 1 << (48 - p.bits)
+}
+
+// First returns the first address in p
+func (p *Prefix) First() *MAC {
+	return p.Addr()
+}
+
+// Last returns the last address in p
+func (p *Prefix) Last() *MAC {
+	return FromUint64(p.addr.Uint64() + p.Size() - 1)
+}
+
+// Contains returns whether m is inside p
+func (p *Prefix) Contains(m *MAC) bool {
+	return prefixKey(m.b, p.bits) == prefixKey(p.addr.b, p.bits)
+}
+
+// Overlap returns the number of addresses that are in p and in q
+func (p *Prefix) Overlap(q *Prefix) uint64 {
+	bits := min(p.bits, q.bits)
+	if prefixKey(p.addr.b, bits) != prefixKey(q.addr.b, bits) {
+		return 0
+	}
+	return 1 << (48 - max(p.bits, q.bits))
+}
+
+// All returns an iterator over all addresses in p in ascending order
+func (p *Prefix) All() iter.Seq[*MAC] {
+	return func(yield func(*MAC) bool) {
+		first := p.addr.Uint64()
+		for i := uint64(0); i < p.Size(); i++ {
+			if !yield(FromUint64(first + i)) {
+				return
+			}
+		}
+	}
+}
+
+// SetPrefix sets the first bits of the MAC to the bits of p
+func (m *MAC) SetPrefix(p *Prefix) {
+	m.setPrefixBits(p.addr.b, p.bits)
+}
+
+// TryParsePrefix parses and returns the MAC prefix in s, e.g.,
+// 52:54:00:00:00:00/24, or an error if s is not a valid prefix
+func TryParsePrefix(s string) (*Prefix, error) {
+	a, l, ok := strings.Cut(s, "/")
+	if !ok {
+		return nil, &ParseError{Input: s, Err: ErrInvalidSyntax}
+	}
+	m, err := TryParse(a)
+	if err != nil {
+		return nil, &ParseError{Input: s, Err: ErrInvalidSyntax}
+	}
+	bits, err := strconv.Atoi(l)
+	if err != nil {
+		return nil, &ParseError{Input: s, Err: ErrInvalidSyntax}
+	}
+	if bits < 0 || bits > 48 {
+		return nil, &ParseError{Input: s, Err: ErrPrefixLength}
+	}
+	return NewPrefix(m, bits)
+}
+
+// ParsePrefix parses and returns the MAC prefix in s
+func ParsePrefix(s string) *Prefix {
+	return must(func() (*Prefix, error) { return TryParsePrefix(s) })
+}
+
+// RandomPrefix returns a random address inside prefix p
+func (g *Generator) RandomPrefix(p *Prefix) (*MAC, error) {
+	m, err := g.Random()
+	if err != nil {
+		return nil, err
+	}
+	m.SetPrefix(p)
+	return m, nil
+}
+
+// TryRandomPrefix returns a random address inside prefix p or an error
+func TryRandomPrefix(p *Prefix) (*MAC, error) {
+	return defaultGenerator.RandomPrefix(p)
+}
+
+// RandomPrefix returns a random address inside prefix p
+func RandomPrefix(p *Prefix) *MAC {
+	return must(func() (*MAC, error) { return TryRandomPrefix(p) })
+}
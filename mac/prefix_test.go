@@ -0,0 +1,108 @@
+package mac
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+)
+
+// TestTryParsePrefix tests TryParsePrefix
+func TestTryParsePrefix(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want string
+	}{
+		{"52:54:00:00:00:00/24", "52:54:00:00:00:00/24"},
+		{"52:54:00:12:34:56/24", "52:54:00:00:00:00/24"},
+		{"02:00:00:ff:00:00/28", "02:00:00:f0:00:00/28"},
+		{"5254.00ff.ffff/0", "00:00:00:00:00:00/0"},
+	} {
+		p, err := TryParsePrefix(test.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := p.String(); got != test.want {
+			t.Errorf("got %s, want %s", got, test.want)
+		}
+	}
+
+	for _, test := range []struct {
+		s   string
+		err error
+	}{
+		{"52:54:00:00:00:00", ErrInvalidSyntax},
+		{"52:54:00/24", ErrInvalidSyntax},
+		{"52:54:00:00:00:00/x", ErrInvalidSyntax},
+		{"52:54:00:00:00:00/49", ErrPrefixLength},
+	} {
+		_, err := TryParsePrefix(test.s)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: got %v, want %v", test.s, err, test.err)
+		}
+	}
+}
+
+// TestPrefixRange tests Contains, First, Last, Size and All of Prefix
+func TestPrefixRange(t *testing.T) {
+	p := ParsePrefix("02:00:00:00:00:f0/44")
+	if got := p.Size(); got != 16 {
+		t.Errorf("got %d, want 16", got)
+	}
+	if got := p.First().String(); got != "02:00:00:00:00:f0" {
+		t.Errorf("got %s, want 02:00:00:00:00:f0", got)
+	}
+	if got := p.Last().String(); got != "02:00:00:00:00:ff" {
+		t.Errorf("got %s, want 02:00:00:00:00:ff", got)
+	}
+	if p.Contains(Parse("02:00:00:00:01:00")) {
+		t.Errorf("prefix %s contains 02:00:00:00:01:00", p)
+	}
+
+	n := 0
+	want := p.First()
+	for m := range p.All() {
+		if m.Compare(want) != 0 || !p.Contains(m) {
+			t.Errorf("got %s, want %s", m, want)
+		}
+		want = want.Next()
+		n++
+	}
+	if n != 16 {
+		t.Errorf("got %d, want 16", n)
+	}
+}
+
+// TestPrefixOverlap tests Overlap of Prefix
+func TestPrefixOverlap(t *testing.T) {
+	for _, test := range []struct {
+		p, q string
+		want uint64
+	}{
+		{"02:00:00:00:00:00/40", "02:00:00:00:00:f0/44", 16},
+		{"02:00:00:00:00:f0/44", "02:00:00:00:00:00/40", 16},
+		{"02:00:00:00:00:f0/44", "02:00:00:00:00:e0/44", 0},
+		{"02:00:00:00:00:f0/44", "02:00:00:00:00:f0/44", 16},
+		{"02:00:00:00:00:00/8", "02:00:00:00:00:01/48", 1},
+	} {
+		got := ParsePrefix(test.p).Overlap(ParsePrefix(test.q))
+		if got != test.want {
+			t.Errorf("%s %s: got %d, want %d", test.p, test.q, got,
+				test.want)
+		}
+	}
+}
+
+// TestGeneratorRandomPrefix tests RandomPrefix of Generator
+func TestGeneratorRandomPrefix(t *testing.T) {
+	g := NewSourceGenerator(rand.NewPCG(1, 2))
+	p := ParsePrefix("02:12:34:50:00:00/28")
+	for i := 0; i < 10; i++ {
+		m, err := g.RandomPrefix(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !p.Contains(m) {
+			t.Errorf("got %s, want address in %s", m, p)
+		}
+	}
+}
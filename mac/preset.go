@@ -0,0 +1,120 @@
+package mac
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Preset is the conventional MAC address range of a hypervisor or container
+// platform
+type Preset struct {
+	// Name is the short name of the preset, e.g., "qemu"
+	Name string
+
+	// Platform is the name of the platform, e.g., "QEMU/KVM"
+	Platform string
+
+	// Prefix is the range of addresses the platform accepts
+	Prefix *Prefix
+}
+
+// String returns p as string
+func (p *Preset) String() string {
+	return fmt.Sprintf("%s %s %s", p.Name, p.Prefix, p.Platform)
+}
+
+// presets contains all presets
+var presets = []*Preset{
+	{"qemu", "QEMU/KVM", ParsePrefix("52:54:00:00:00:00/24")},
+	{"xen", "Xen", ParsePrefix("00:16:3e:00:00:00/24")},
+	// VMware reserves 00:50:56:00:00:00 to 00:50:56:3f:ff:ff for
+	// manually assigned addresses
+	{"vmware", "VMware (manual)", ParsePrefix("00:50:56:00:00:00/26")},
+	{"virtualbox", "VirtualBox", ParsePrefix("08:00:27:00:00:00/24")},
+	{"hyperv", "Hyper-V", ParsePrefix("00:15:5d:00:00:00/24")},
+	{"docker", "Docker", ParsePrefix("02:42:00:00:00:00/16")},
+}
+
+// detectPresets contains the ranges of platforms that generate addresses
+// themselves, they are only used to detect the platform of an address, not
+// to generate addresses
+var detectPresets = []*Preset{
+	// VMware hosts generate addresses in 00:0c:29 and vCenter Server
+	// in 00:50:56:80:00:00 to 00:50:56:bf:ff:ff
+	{"vmware", "VMware (host generated)",
+		ParsePrefix("00:0c:29:00:00:00/24")},
+	{"vmware", "VMware (vCenter generated)",
+		ParsePrefix("00:50:56:80:00:00/26")},
+}
+
+// Presets returns all presets
+func Presets() []*Preset {
+	return append([]*Preset(nil), presets...)
+}
+
+// PresetNames returns the names of all presets
+func PresetNames() []string {
+	names := make([]string, len(presets))
+	for i, p := range presets {
+		names[i] = p.Name
+	}
+	return names
+}
+
+// LookupPreset returns the preset with name
+func LookupPreset(name string) (*Preset, error) {
+	for _, p := range presets {
+		if p.Name == strings.ToLower(name) {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("mac: %w: %s", ErrUnknownPreset, name)
+}
+
+// FindPreset returns the preset with the range containing m or nil if m is
+// not in a preset range, it also finds the ranges of addresses generated by
+// the platforms, e.g., VMware's, which are not in Presets
+func FindPreset(m *MAC) *Preset {
+	for _, list := range [][]*Preset{presets, detectPresets} {
+		for _, p := range list {
+			if p.Prefix.Contains(m) {
+				return p
+			}
+		}
+	}
+	return nil
+}
+
+// Preset returns the platform of the preset range the MAC is in or an empty
+// string if it is not in a preset range
+func (m *MAC) Preset() string {
+	if p := FindPreset(m); p != nil {
+		return p.Platform
+	}
+	return ""
+}
+
+// explainPreset returns an explanation of the preset range of the MAC as
+// string
+func (m *MAC) explainPreset() string {
+	if p := FindPreset(m); p != nil {
+		return fmt.Sprintf("\nPreset: %s in %s", p.Platform, p.Prefix)
+	}
+	return ""
+}
+
+// RandomPreset returns a random address in the range of preset p
+func (g *Generator) RandomPreset(p *Preset) (*MAC, error) {
+	return g.RandomPrefix(p.Prefix)
+}
+
+// TryRandomPreset returns a random address in the range of preset p or an
+// error
+func TryRandomPreset(p *Preset) (*MAC, error) {
+	return defaultGenerator.RandomPreset(p)
+}
+
+// RandomPreset returns a random address in the range of preset p
+func RandomPreset(p *Preset) *MAC {
+	return must(func() (*MAC, error) { return TryRandomPreset(p) })
+}
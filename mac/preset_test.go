@@ -0,0 +1,74 @@
+package mac
+
+import (
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+// TestLookupPreset tests LookupPreset
+func TestLookupPreset(t *testing.T) {
+	for _, name := range PresetNames() {
+		p, err := LookupPreset(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Name != name {
+			t.Errorf("got %s, want %s", p.Name, name)
+		}
+	}
+
+	if _, err := LookupPreset("bochs"); !errors.Is(err, ErrUnknownPreset) {
+		t.Errorf("got %v, want %v", err, ErrUnknownPreset)
+	}
+
+	// only the manual VMware range is used for generating addresses
+	p, err := LookupPreset("vmware")
+	if err != nil || p.Prefix.String() != "00:50:56:00:00:00/26" {
+		t.Errorf("got %v %v, want 00:50:56:00:00:00/26", p, err)
+	}
+}
+
+// TestFindPreset tests Preset of MAC and the Explain output
+func TestFindPreset(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want string
+	}{
+		{"52:54:00:12:34:56", "QEMU/KVM"},
+		{"00:50:56:3f:ff:ff", "VMware (manual)"},
+		{"00:50:56:40:00:00", ""},
+		{"00:50:56:80:12:34", "VMware (vCenter generated)"},
+		{"00:50:56:bf:ff:ff", "VMware (vCenter generated)"},
+		{"00:50:56:c0:00:00", ""},
+		{"00:0c:29:12:34:56", "VMware (host generated)"},
+		{"02:42:ac:11:00:02", "Docker"},
+		{"0a:00:27:00:00:01", ""},
+	} {
+		m := Parse(test.s)
+		if got := m.Preset(); got != test.want {
+			t.Errorf("%s: got %q, want %q", test.s, got, test.want)
+		}
+		if test.want != "" &&
+			!strings.Contains(m.Explain(), "Preset: "+test.want) {
+			t.Errorf("%s: preset missing in Explain", test.s)
+		}
+	}
+}
+
+// TestGeneratorRandomPreset tests RandomPreset of Generator
+func TestGeneratorRandomPreset(t *testing.T) {
+	g := NewSourceGenerator(rand.NewPCG(1, 2))
+	for _, p := range Presets() {
+		for i := 0; i < 10; i++ {
+			m, err := g.RandomPreset(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if FindPreset(m) != p {
+				t.Errorf("got %s, want address in %s", m, p)
+			}
+		}
+	}
+}
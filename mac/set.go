@@ -0,0 +1,106 @@
+package mac
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"iter"
+	"os"
+	"strings"
+)
+
+// MaxAvoidAttempts is the maximum number of candidates Set.Avoid generates
+// before it gives up
+const MaxAvoidAttempts = 1000
+
+// Set is a set of known MAC addresses, e.g., the addresses present on a
+// network segment
+type Set struct {
+	m map[[6]byte]struct{}
+}
+
+// NewSet returns a new set with the addresses in macs
+func NewSet(macs ...*MAC) *Set {
+	s := &Set{m: make(map[[6]byte]struct{})}
+	s.Add(macs...)
+	return s
+}
+
+// Add adds the addresses in macs to s
+func (s *Set) Add(macs ...*MAC) {
+	for _, m := range macs {
+		s.m[m.b] = struct{}{}
+	}
+}
+
+// Contains returns whether m is in s
+func (s *Set) Contains(m *MAC) bool {
+	_, ok := s.m[m.b]
+	return ok
+}
+
+// Len returns the number of addresses in s
+func (s *Set) Len() int {
+	return len(s.m)
+}
+
+// All returns an iterator over all addresses in s in no particular order
+func (s *Set) All() iter.Seq[*MAC] {
+	return func(yield func(*MAC) bool) {
+		for b := range s.m {
+			if !yield(&MAC{b: b}) {
+				return
+			}
+		}
+	}
+}
+
+// Load adds the addresses in rd to s, rd contains one address per line,
+// empty lines and lines starting with # are ignored
+func (s *Set) Load(rd io.Reader) error {
+	sc := bufio.NewScanner(rd)
+	for i := 1; sc.Scan(); i++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		m, err := TryParse(line)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", i, err)
+		}
+		s.Add(m)
+	}
+	return sc.Err()
+}
+
+// LoadFile adds the addresses in the file in path to s, see Load for the
+// file format
+func (s *Set) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := s.Load(f); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// Avoid calls random until it returns an address that is not in s, e.g.,
+// with random set to Generator.RandomLI. It returns the address and the
+// number of rejected candidates. If no free address is found after
+// MaxAvoidAttempts candidates, it returns ErrNoFreeAddress
+func (s *Set) Avoid(random func() (*MAC, error)) (*MAC, int, error) {
+	for rejected := 0; rejected < MaxAvoidAttempts; rejected++ {
+		m, err := random()
+		if err != nil {
+			return nil, rejected, err
+		}
+		if !s.Contains(m) {
+			return m, rejected, nil
+		}
+	}
+	return nil, MaxAvoidAttempts, fmt.Errorf("mac: %w after %d candidates",
+		ErrNoFreeAddress, MaxAvoidAttempts)
+}
@@ -0,0 +1,86 @@
+package mac
+
+import (
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+// TestSetLoad tests Load of Set
+func TestSetLoad(t *testing.T) {
+	in := `# known addresses
+52:54:00:12:34:56
+
+52-54-00-AB-CD-EF
+52:54:00:12:34:56
+`
+	s := NewSet()
+	if err := s.Load(strings.NewReader(in)); err != nil {
+		t.Fatal(err)
+	}
+	if s.Len() != 2 {
+		t.Errorf("got %d, want 2", s.Len())
+	}
+	if !s.Contains(Parse("52:54:00:ab:cd:ef")) {
+		t.Errorf("52:54:00:ab:cd:ef not in set")
+	}
+	if s.Contains(Parse("52:54:00:00:00:00")) {
+		t.Errorf("52:54:00:00:00:00 in set")
+	}
+
+	err := s.Load(strings.NewReader("52:54:00:12:34:56\nfoo\n"))
+	if !errors.Is(err, ErrInvalidSyntax) && !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("got %v, want parse error", err)
+	}
+}
+
+// TestSetAll tests All of Set
+func TestSetAll(t *testing.T) {
+	want := NewSet(Parse("52:54:00:12:34:56"), Parse("52:54:00:ab:cd:ef"))
+	got := NewSet()
+	for m := range want.All() {
+		got.Add(m)
+	}
+	if got.Len() != want.Len() {
+		t.Errorf("got %d, want %d", got.Len(), want.Len())
+	}
+	for m := range got.All() {
+		if !want.Contains(m) {
+			t.Errorf("got unexpected %s", m)
+		}
+	}
+}
+
+// TestSetAvoid tests Avoid of Set
+func TestSetAvoid(t *testing.T) {
+	// the first two candidates are known
+	g := NewSourceGenerator(rand.NewPCG(1, 2))
+	first, _ := g.RandomLI()
+	second, _ := g.RandomLI()
+	want, _ := g.RandomLI()
+
+	g = NewSourceGenerator(rand.NewPCG(1, 2))
+	s := NewSet(first, second)
+	m, rejected, err := s.Avoid(g.RandomLI)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rejected != 2 {
+		t.Errorf("got %d rejected, want 2", rejected)
+	}
+	if m.Compare(want) != 0 {
+		t.Errorf("got %s, want %s", m, want)
+	}
+
+	// all candidates are known
+	bc := Parse("ff:ff:ff:ff:ff:ff")
+	s = NewSet(bc)
+	_, rejected, err = s.Avoid(func() (*MAC, error) { return bc, nil })
+	if !errors.Is(err, ErrNoFreeAddress) {
+		t.Errorf("got %v, want %v", err, ErrNoFreeAddress)
+	}
+	if rejected != MaxAvoidAttempts {
+		t.Errorf("got %d rejected, want %d", rejected, MaxAvoidAttempts)
+	}
+}
@@ -0,0 +1,195 @@
+package mac
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Quadrant is an IEEE 802c Structured Local Address Plan (SLAP) quadrant of
+// locally administered addresses, it is selected by the Y and Z bits
+type Quadrant int
+
+// SLAP quadrants
+const (
+	// QuadrantNone is the quadrant of universally administered addresses
+	QuadrantNone Quadrant = iota
+
+	// QuadrantAAI is the Administratively Assigned Identifier quadrant,
+	// Y bit 0 and Z bit 0
+	QuadrantAAI
+
+	// QuadrantELI is the Extended Local Identifier quadrant,
+	// Y bit 0 and Z bit 1
+	QuadrantELI
+
+	// QuadrantSAI is the Standard Assigned Identifier quadrant,
+	// Y bit 1 and Z bit 1
+	QuadrantSAI
+
+	// QuadrantReserved is the reserved quadrant,
+	// Y bit 1 and Z bit 0
+	QuadrantReserved
+)
+
+const (
+	// bitY is the Y bit in the first byte of the MAC
+	bitY = 0b00000100
+
+	// bitZ is the Z bit in the first byte of the MAC
+	bitZ = 0b00001000
+)
+
+// String returns q as string
+func (q Quadrant) String() string {
+	switch q {
+	case QuadrantNone:
+		return "None (Universal)"
+	case QuadrantAAI:
+		return "AAI (Administratively Assigned Identifier)"
+	case QuadrantELI:
+		return "ELI (Extended Local Identifier)"
+	case QuadrantSAI:
+		return "SAI (Standard Assigned Identifier)"
+	case QuadrantReserved:
+		return "Reserved"
+	}
+	return fmt.Sprintf("Quadrant(%d)", int(q))
+}
+
+// ParseQuadrant parses the quadrant name in s, e.g., "aai", "eli", "sai" or
+// "reserved"
+func ParseQuadrant(s string) (Quadrant, error) {
+	switch strings.ToLower(s) {
+	case "aai":
+		return QuadrantAAI, nil
+	case "eli":
+		return QuadrantELI, nil
+	case "sai":
+		return QuadrantSAI, nil
+	case "reserved":
+		return QuadrantReserved, nil
+	}
+	return QuadrantNone, &ParseError{Input: s, Err: ErrInvalidSyntax}
+}
+
+// Quadrant returns the SLAP quadrant of the MAC
+func (m *MAC) Quadrant() Quadrant {
+	if m.Universal() {
+		return QuadrantNone
+	}
+	y := m.b[0]&bitY != 0
+	z := m.b[0]&bitZ != 0
+	switch {
+	case !y && !z:
+		return QuadrantAAI
+	case !y && z:
+		return QuadrantELI
+	case y && z:
+		return QuadrantSAI
+	}
+	return QuadrantReserved
+}
+
+// SetQuadrant sets the MAC to local and the Y and Z bits to quadrant q,
+// QuadrantNone sets the MAC to universal
+func (m *MAC) SetQuadrant(q Quadrant) {
+	if q == QuadrantNone {
+		m.SetUniversal()
+		return
+	}
+	m.SetLocal()
+	m.b[0] &^= bitY | bitZ
+	switch q {
+	case QuadrantELI:
+		m.b[0] |= bitZ
+	case QuadrantSAI:
+		m.b[0] |= bitY | bitZ
+	case QuadrantReserved:
+		m.b[0] |= bitY
+	}
+}
+
+// CID returns the Company ID part of the MAC as string if the MAC is in the
+// ELI quadrant, otherwise an empty string
+func (m *MAC) CID() string {
+	if m.Quadrant() != QuadrantELI {
+		return ""
+	}
+	return m.OUI()
+}
+
+// checkCID checks if cid is a valid Company ID
+func checkCID(cid [3]byte) error {
+	m := &MAC{}
+	m.SetOUI(cid)
+	if m.Quadrant() != QuadrantELI || m.Group() {
+		return fmt.Errorf("mac: %w: %s", ErrInvalidCID, m.OUI())
+	}
+	return nil
+}
+
+// RandomQuadrant returns a random individual address in quadrant q
+func (g *Generator) RandomQuadrant(q Quadrant) (*MAC, error) {
+	m, err := g.Random()
+	if err != nil {
+		return nil, err
+	}
+	m.SetQuadrant(q)
+	m.SetIndividual()
+	return m, nil
+}
+
+// RandomAAI returns a random individual address in the AAI quadrant
+func (g *Generator) RandomAAI() (*MAC, error) {
+	return g.RandomQuadrant(QuadrantAAI)
+}
+
+// RandomSAI returns a random individual address in the SAI quadrant
+func (g *Generator) RandomSAI() (*MAC, error) {
+	return g.RandomQuadrant(QuadrantSAI)
+}
+
+// RandomELI returns a random individual address in the ELI quadrant with
+// the Company ID cid
+func (g *Generator) RandomELI(cid [3]byte) (*MAC, error) {
+	if err := checkCID(cid); err != nil {
+		return nil, err
+	}
+	m, err := g.Random()
+	if err != nil {
+		return nil, err
+	}
+	m.SetOUI(cid)
+	return m, nil
+}
+
+// TryRandomAAI returns a random individual AAI address or an error
+func TryRandomAAI() (*MAC, error) {
+	return defaultGenerator.RandomAAI()
+}
+
+// RandomAAI returns a random individual AAI address
+func RandomAAI() *MAC {
+	return must(TryRandomAAI)
+}
+
+// TryRandomSAI returns a random individual SAI address or an error
+func TryRandomSAI() (*MAC, error) {
+	return defaultGenerator.RandomSAI()
+}
+
+// RandomSAI returns a random individual SAI address
+func RandomSAI() *MAC {
+	return must(TryRandomSAI)
+}
+
+// TryRandomELI returns a random individual ELI address with the Company ID
+// cid or an error
+func TryRandomELI(cid [3]byte) (*MAC, error) {
+	return defaultGenerator.RandomELI(cid)
+}
+
+// RandomELI returns a random individual ELI address with the Company ID cid
+func RandomELI(cid [3]byte) *MAC {
+	return must(func() (*MAC, error) { return TryRandomELI(cid) })
+}
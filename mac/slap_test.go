@@ -0,0 +1,99 @@
+package mac
+
+import (
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+// TestQuadrant tests Quadrant of MAC
+func TestQuadrant(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want Quadrant
+	}{
+		{"00:00:5e:00:53:01", QuadrantNone},
+		{"02:00:5e:00:53:01", QuadrantAAI},
+		{"0a:00:5e:00:53:01", QuadrantELI},
+		{"06:00:5e:00:53:01", QuadrantReserved},
+		{"0e:00:5e:00:53:01", QuadrantSAI},
+		{"0f:00:5e:00:53:01", QuadrantSAI},
+	} {
+		got := Parse(test.s).Quadrant()
+		if got != test.want {
+			t.Errorf("%s: got %s, want %s", test.s, got, test.want)
+		}
+	}
+}
+
+// TestSetQuadrant tests SetQuadrant of MAC
+func TestSetQuadrant(t *testing.T) {
+	for _, q := range []Quadrant{QuadrantNone, QuadrantAAI, QuadrantELI,
+		QuadrantSAI, QuadrantReserved} {
+		m := Parse("ff:ff:ff:ff:ff:ff")
+		m.SetQuadrant(q)
+		if got := m.Quadrant(); got != q {
+			t.Errorf("got %s, want %s", got, q)
+		}
+	}
+}
+
+// TestGeneratorRandomQuadrant tests the SLAP random functions of Generator
+func TestGeneratorRandomQuadrant(t *testing.T) {
+	g := NewSourceGenerator(rand.NewPCG(1, 2))
+	for _, test := range []struct {
+		random func() (*MAC, error)
+		want   Quadrant
+	}{
+		{g.RandomAAI, QuadrantAAI},
+		{g.RandomSAI, QuadrantSAI},
+		{func() (*MAC, error) {
+			return g.RandomELI([3]byte{0x0a, 0x11, 0x22})
+		}, QuadrantELI},
+	} {
+		m, err := test.random()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Quadrant() != test.want || !m.Individual() {
+			t.Errorf("got %s %s, want %s", m, m.Quadrant(), test.want)
+		}
+	}
+
+	// test invalid CID
+	_, err := g.RandomELI([3]byte{0x00, 0x11, 0x22})
+	if !errors.Is(err, ErrInvalidCID) {
+		t.Errorf("got %v, want %v", err, ErrInvalidCID)
+	}
+}
+
+// TestRegistryLookupCID tests Lookup of ELI addresses in a CID registry
+func TestRegistryLookupCID(t *testing.T) {
+	r := NewRegistry()
+	cid := `Registry,Assignment,Organization Name,Organization Address
+CID,0A1122,Test CID,Test Address
+`
+	if err := r.Load(strings.NewReader(cid)); err != nil {
+		t.Fatal(err)
+	}
+
+	g := NewSourceGenerator(rand.NewPCG(1, 2))
+	m, err := g.RandomELI([3]byte{0x0a, 0x11, 0x22})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := r.Lookup(m)
+	if a == nil || a.Registry != "CID" || a.Organization != "Test CID" {
+		t.Errorf("%s: got %v, want Test CID", m, a)
+	}
+	if got := m.CID(); got != "0a:11:22" {
+		t.Errorf("got %s, want 0a:11:22", got)
+	}
+
+	// addresses in other quadrants are not in the CID block
+	m.SetQuadrant(QuadrantAAI)
+	if a := r.Lookup(m); a != nil {
+		t.Errorf("%s: got %v, want nil", m, a)
+	}
+}
@@ -0,0 +1,210 @@
+package mac
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// WellKnown is a well-known MAC address range with a special meaning, e.g.,
+// the broadcast address or the addresses used by a protocol
+type WellKnown struct {
+	// Prefix contains the bits of the range, left aligned, the unused
+	// bits are 0
+	Prefix [6]byte
+
+	// Bits is the length of the prefix in bits
+	Bits int
+
+	// Name is the name of the range, e.g., "LLDP, Nearest Bridge"
+	Name string
+
+	// Standard is the standard or organization that defines the range,
+	// e.g., "IEEE 802.1AB"
+	Standard string
+}
+
+// Block returns the range of w as string, e.g., "33:33:00:00:00:00/16"
+func (w *WellKnown) Block() string {
+	return fmt.Sprintf("%s/%d", (&MAC{b: w.Prefix}).Hex(), w.Bits)
+}
+
+// Description returns the name and standard of w as string
+func (w *WellKnown) Description() string {
+	return fmt.Sprintf("%s (%s)", w.Name, w.Standard)
+}
+
+// String returns w as string
+func (w *WellKnown) String() string {
+	return fmt.Sprintf("%s %s", w.Block(), w.Description())
+}
+
+// Contains returns whether m is inside the range of w
+func (w *WellKnown) Contains(m *MAC) bool {
+	return prefixKey(m.b, w.Bits) == prefixKey(w.Prefix, w.Bits)
+}
+
+// Range returns the range of w as prefix
+func (w *WellKnown) Range() *Prefix {
+	return newPrefix(w.Prefix, w.Bits)
+}
+
+// newWellKnown returns a new well-known range with the prefix in s
+func newWellKnown(s string, bits int, name, standard string) *WellKnown {
+	return &WellKnown{
+		Prefix:   Parse(s).b,
+		Bits:     bits,
+		Name:     name,
+		Standard: standard,
+	}
+}
+
+// wellKnown contains all well-known ranges sorted by prefix
+var wellKnown = []*WellKnown{
+	newWellKnown("00:00:0c:07:ac:00", 40, "HSRPv1 virtual router", "Cisco"),
+	newWellKnown("00:00:0c:9f:f0:00", 36, "HSRPv2 virtual router", "Cisco"),
+	newWellKnown("00:00:5e:00:00:00", 24, "IANA unicast", "RFC 9542"),
+	newWellKnown("00:00:5e:00:01:00", 40, "VRRP IPv4 virtual router",
+		"RFC 5798"),
+	newWellKnown("00:00:5e:00:02:00", 40, "VRRP IPv6 virtual router",
+		"RFC 5798"),
+	newWellKnown("00:00:5e:00:53:00", 40, "IANA unicast documentation",
+		"RFC 9542"),
+	newWellKnown("01:00:0c:cc:cc:cc", 48, "CDP, VTP, DTP, PAgP, UDLD",
+		"Cisco"),
+	newWellKnown("01:00:0c:cc:cc:cd", 48, "PVST+", "Cisco"),
+	newWellKnown("01:00:5e:00:00:00", 25, "IPv4 multicast mapped",
+		"RFC 1112"),
+	newWellKnown("01:00:5e:80:00:00", 25, "IANA multicast", "RFC 9542"),
+	newWellKnown("01:00:5e:90:10:00", 40, "IANA multicast documentation",
+		"RFC 9542"),
+	newWellKnown("01:1b:19:00:00:00", 48, "PTP", "IEEE 1588"),
+	newWellKnown("01:80:c2:00:00:00", 44, "Reserved link-local multicast",
+		"IEEE 802.1Q"),
+	newWellKnown("01:80:c2:00:00:00", 48, "Nearest Customer Bridge, STP",
+		"IEEE 802.1D"),
+	newWellKnown("01:80:c2:00:00:01", 48, "MAC Control, Pause",
+		"IEEE 802.3"),
+	newWellKnown("01:80:c2:00:00:02", 48, "Slow Protocols, LACP",
+		"IEEE 802.3"),
+	newWellKnown("01:80:c2:00:00:03", 48, "Nearest non-TPMR Bridge, 802.1X",
+		"IEEE 802.1Q"),
+	newWellKnown("01:80:c2:00:00:0e", 48, "Nearest Bridge, LLDP",
+		"IEEE 802.1AB"),
+	newWellKnown("01:80:c2:00:00:20", 44, "MRP applications, MMRP, MVRP",
+		"IEEE 802.1Q"),
+	newWellKnown("01:80:c2:00:00:30", 44, "Connectivity Fault Management",
+		"IEEE 802.1Q"),
+	newWellKnown("33:33:00:00:00:00", 16, "IPv6 multicast mapped",
+		"RFC 2464"),
+	newWellKnown("ff:ff:ff:ff:ff:ff", 48, "Broadcast", "IEEE 802"),
+}
+
+// WellKnownRanges returns all well-known ranges sorted by prefix
+func WellKnownRanges() []*WellKnown {
+	return append([]*WellKnown(nil), wellKnown...)
+}
+
+// LookupWellKnown returns the well-known range containing m or nil if m is
+// not in a well-known range, the longest matching range wins
+func LookupWellKnown(m *MAC) *WellKnown {
+	var found *WellKnown
+	for _, w := range wellKnown {
+		if w.Contains(m) && (found == nil || w.Bits > found.Bits) {
+			found = w
+		}
+	}
+	return found
+}
+
+// nestedWellKnown returns whether the well-known range wellKnown[i] is
+// inside another well-known range
+func nestedWellKnown(i int) bool {
+	r := wellKnown[i].Range()
+	for j, w := range wellKnown {
+		o := w.Range()
+		if j == i || o.bits > r.bits || (o.bits == r.bits && j > i) {
+			continue
+		}
+		if o.Overlap(r) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// WellKnownSize returns the number of addresses in p that are inside
+// well-known ranges, addresses in nested ranges are counted once
+func (p *Prefix) WellKnownSize() uint64 {
+	var n uint64
+	for i, w := range wellKnown {
+		if !nestedWellKnown(i) {
+			n += p.Overlap(w.Range())
+		}
+	}
+	return n
+}
+
+// FindWellKnown returns all well-known ranges with a name or standard
+// matching name, name is a case-insensitive regular expression
+func FindWellKnown(name string) ([]*WellKnown, error) {
+	re, err := regexp.Compile("(?i)" + name)
+	if err != nil {
+		return nil, err
+	}
+	var found []*WellKnown
+	for _, w := range wellKnown {
+		if re.MatchString(w.Name) || re.MatchString(w.Standard) {
+			found = append(found, w)
+		}
+	}
+	return found, nil
+}
+
+// WellKnown returns the description of the well-known range the MAC is in
+// or an empty string if it is not in a well-known range
+func (m *MAC) WellKnown() string {
+	if w := LookupWellKnown(m); w != nil {
+		return w.Description()
+	}
+	return ""
+}
+
+// wellKnownString returns the well-known range of the MAC for display
+func (m *MAC) wellKnownString() string {
+	if w := m.WellKnown(); w != "" {
+		return w
+	}
+	return "None"
+}
+
+// explainWellKnown returns an explanation of the well-known range of the MAC
+// as string
+func (m *MAC) explainWellKnown() string {
+	if w := m.WellKnown(); w != "" {
+		return "\nWell-known: " + w
+	}
+	return ""
+}
+
+// RandomWellKnown returns a random address inside one of the well-known
+// ranges, the range is picked randomly weighted by its size
+func (g *Generator) RandomWellKnown(ranges []*WellKnown) (*MAC, error) {
+	if len(ranges) == 0 {
+		return nil, ErrNoAssignment
+	}
+	bits := make([]int, len(ranges))
+	for i, w := range ranges {
+		bits[i] = w.Bits
+	}
+	i, err := g.pickBlock(bits)
+	if err != nil {
+		return nil, err
+	}
+	m, err := g.Random()
+	if err != nil {
+		return nil, err
+	}
+	w := ranges[i]
+	m.setPrefixBits(w.Prefix, w.Bits)
+	return m, nil
+}
@@ -0,0 +1,101 @@
+package mac
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+// TestLookupWellKnown tests LookupWellKnown
+func TestLookupWellKnown(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want string
+	}{
+		{"01:80:c2:00:00:0e", "Nearest Bridge, LLDP (IEEE 802.1AB)"},
+		{"01:80:c2:00:00:0d", "Reserved link-local multicast (IEEE 802.1Q)"},
+		{"01:00:5e:00:00:fb", "IPv4 multicast mapped (RFC 1112)"},
+		{"01:00:5e:80:00:fb", "IANA multicast (RFC 9542)"},
+		{"33:33:00:00:00:01", "IPv6 multicast mapped (RFC 2464)"},
+		{"ff:ff:ff:ff:ff:ff", "Broadcast (IEEE 802)"},
+		{"00:00:5e:00:01:0a", "VRRP IPv4 virtual router (RFC 5798)"},
+		{"01:00:0c:cc:cc:cd", "PVST+ (Cisco)"},
+		{"52:54:00:12:34:56", ""},
+	} {
+		got := Parse(test.s).WellKnown()
+		if got != test.want {
+			t.Errorf("%s: got %q, want %q", test.s, got, test.want)
+		}
+	}
+}
+
+// TestExplainWellKnown tests Explain and Table of well-known addresses
+func TestExplainWellKnown(t *testing.T) {
+	m := Parse("01:80:c2:00:00:0e")
+	want := "Well-known: Nearest Bridge, LLDP (IEEE 802.1AB)"
+	if got := m.Explain(); !strings.Contains(got, want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	want = "| Well-known   | Nearest Bridge, LLDP (IEEE 802.1AB)"
+	if got := m.Table(); !strings.Contains(got, want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+// TestFindWellKnown tests FindWellKnown
+func TestFindWellKnown(t *testing.T) {
+	found, err := FindWellKnown("vrrp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 2 {
+		t.Errorf("got %d, want 2", len(found))
+	}
+
+	if _, err := FindWellKnown("("); err == nil {
+		t.Errorf("got nil, want error")
+	}
+}
+
+// TestPrefixWellKnownSize tests WellKnownSize of Prefix
+func TestPrefixWellKnownSize(t *testing.T) {
+	for _, test := range []struct {
+		prefix string
+		want   uint64
+	}{
+		// broadcast
+		{"ff:ff:ff:ff:ff:f0/44", 1},
+		// three /44 ranges, the nested /48 ranges count once
+		{"01:80:c2:00:00:00/40", 48},
+		// inside the IANA unicast range
+		{"00:00:5e:00:53:00/44", 16},
+		{"02:00:00:00:00:00/24", 0},
+	} {
+		got := ParsePrefix(test.prefix).WellKnownSize()
+		if got != test.want {
+			t.Errorf("%s: got %d, want %d", test.prefix, got, test.want)
+		}
+	}
+}
+
+// TestGeneratorRandomWellKnown tests RandomWellKnown of Generator
+func TestGeneratorRandomWellKnown(t *testing.T) {
+	g := NewSourceGenerator(rand.NewPCG(1, 2))
+	found, err := FindWellKnown("IPv4 multicast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		m, err := g.RandomWellKnown(found)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if LookupWellKnown(m) != found[0] {
+			t.Errorf("got %s, want address in %s", m, found[0])
+		}
+	}
+
+	if _, err := g.RandomWellKnown(nil); err != ErrNoAssignment {
+		t.Errorf("got %v, want %v", err, ErrNoAssignment)
+	}
+}